@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/wowmysql/wowmysql-go/wowmysql"
 )
@@ -21,7 +20,7 @@ func main() {
 		"your-api-key",
 	)
 
-	fmt.Println("=== DATABASE OPERATIONS ===\n")
+	fmt.Print("=== DATABASE OPERATIONS ===\n\n")
 
 	// 1. List all tables
 	fmt.Println("1. List all tables")
@@ -128,7 +127,7 @@ func main() {
 		fmt.Printf("Count: %v\n\n", sqlResults[0]["count"])
 	}
 
-	fmt.Println("=== STORAGE OPERATIONS ===\n")
+	fmt.Print("=== STORAGE OPERATIONS ===\n\n")
 
 	// 1. Get storage quota
 	fmt.Println("1. Get storage quota")
@@ -195,7 +194,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to delete file: %v", err)
 	}
-	fmt.Println("File deleted\n")
+	fmt.Print("File deleted\n\n")
 
 	// 8. Delete multiple files
 	fmt.Println("8. Delete multiple files")
@@ -206,7 +205,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to delete files: %v", err)
 	}
-	fmt.Println("Multiple files deleted\n")
+	fmt.Print("Multiple files deleted\n\n")
 
 	// 9. Check API health
 	fmt.Println("9. Check API health")
@@ -0,0 +1,255 @@
+package wowmysql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AggregateFunction represents an SQL aggregate function
+type AggregateFunction string
+
+const (
+	AggCount AggregateFunction = "count"
+	AggSum   AggregateFunction = "sum"
+	AggAvg   AggregateFunction = "avg"
+	AggMin   AggregateFunction = "min"
+	AggMax   AggregateFunction = "max"
+)
+
+// Aggregate is an aggregate expression such as SUM(total) AS sum_total
+type Aggregate struct {
+	Function AggregateFunction `json:"function"`
+	Column   string            `json:"column"`
+	Alias    string            `json:"alias"`
+}
+
+// Count counts rows; use "*" to count all rows or a column to count its non-NULL values
+func Count(column string) Aggregate {
+	return newAggregate(AggCount, column)
+}
+
+// Sum adds up the values of a column
+func Sum(column string) Aggregate {
+	return newAggregate(AggSum, column)
+}
+
+// Avg averages the values of a column
+func Avg(column string) Aggregate {
+	return newAggregate(AggAvg, column)
+}
+
+// Min returns the smallest value of a column
+func Min(column string) Aggregate {
+	return newAggregate(AggMin, column)
+}
+
+// Max returns the largest value of a column
+func Max(column string) Aggregate {
+	return newAggregate(AggMax, column)
+}
+
+// newAggregate builds an aggregate with its default alias, e.g. "sum_total" or "count"
+func newAggregate(function AggregateFunction, column string) Aggregate {
+	alias := string(function)
+	if column != "*" && column != "" {
+		alias += "_" + column
+	}
+	return Aggregate{Function: function, Column: column, Alias: alias}
+}
+
+// As returns a copy of the aggregate with a different result column name
+func (a Aggregate) As(alias string) Aggregate {
+	a.Alias = alias
+	return a
+}
+
+// AggregateValue is a single aggregate result
+type AggregateValue struct {
+	raw interface{}
+}
+
+// IsNull reports whether the value is NULL, e.g. SUM over no rows
+func (v AggregateValue) IsNull() bool {
+	return v.raw == nil
+}
+
+// Int64 returns the value as an integer; NULL is returned as 0
+func (v AggregateValue) Int64() (int64, error) {
+	switch raw := v.raw.(type) {
+	case nil:
+		return 0, nil
+	case interface{ Int64() (int64, error) }:
+		return raw.Int64()
+	case string:
+		return strconv.ParseInt(raw, 10, 64)
+	default:
+		return 0, fmt.Errorf("aggregate value %v is not an integer", raw)
+	}
+}
+
+// Float64 returns the value as a float; NULL is returned as 0
+func (v AggregateValue) Float64() (float64, error) {
+	switch raw := v.raw.(type) {
+	case nil:
+		return 0, nil
+	case interface{ Float64() (float64, error) }:
+		return raw.Float64()
+	case string:
+		return strconv.ParseFloat(raw, 64)
+	default:
+		return 0, fmt.Errorf("aggregate value %v is not a number", raw)
+	}
+}
+
+// String returns the value as text, keeping DECIMAL results exact; NULL is returned as ""
+func (v AggregateValue) String() string {
+	if v.raw == nil {
+		return ""
+	}
+	return fmt.Sprint(v.raw)
+}
+
+// Interface returns the decoded JSON value (json.Number, string or nil)
+func (v AggregateValue) Interface() interface{} {
+	return v.raw
+}
+
+// AggregateRow is one result row of an aggregate query
+type AggregateRow struct {
+	// Group holds the GroupBy column values of the row
+	Group map[string]interface{}
+	// Values holds the aggregate results keyed by alias
+	Values map[string]AggregateValue
+}
+
+// Value returns the aggregate result with the given alias
+func (r AggregateRow) Value(alias string) AggregateValue {
+	return r.Values[alias]
+}
+
+// AggregateResponse represents the result of an aggregate query
+type AggregateResponse struct {
+	Rows []AggregateRow
+}
+
+// Value returns an aggregate result of the first row, for queries without GroupBy
+func (r *AggregateResponse) Value(alias string) AggregateValue {
+	if len(r.Rows) == 0 {
+		return AggregateValue{}
+	}
+	return r.Rows[0].Value(alias)
+}
+
+// Aggregate adds aggregate expressions to the query
+//
+//	resp, err := client.Table("orders").Where().
+//		Aggregate(wowmysql.Count("*"), wowmysql.Sum("total")).
+//		GroupBy("status").
+//		Having(func(h *wowmysql.QueryBuilder) { h.Gt("count", 10) }).
+//		ExecuteAggregate()
+func (qb *QueryBuilder) Aggregate(aggregates ...Aggregate) *QueryBuilder {
+	qb = qb.mutable()
+	qb.aggregates = append(qb.aggregates, aggregates...)
+	return qb
+}
+
+// GroupBy groups the rows by the given columns
+func (qb *QueryBuilder) GroupBy(columns ...string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.groupBy = append(qb.groupBy, columns...)
+	return qb
+}
+
+// Having filters grouped rows. Conditions are added with the usual filter methods
+// and may refer to GroupBy columns or aggregate aliases.
+func (qb *QueryBuilder) Having(group func(*QueryBuilder)) *QueryBuilder {
+	qb = qb.mutable()
+	sub := &QueryBuilder{
+		client:    qb.client,
+		tableName: qb.tableName,
+		filters:   make([]FilterExpression, 0),
+	}
+	group(sub)
+
+	qb.having = append(qb.having, sub.filters...)
+	return qb
+}
+
+// ExecuteAggregate executes an aggregate query
+func (qb *QueryBuilder) ExecuteAggregate() (*AggregateResponse, error) {
+	return qb.ExecuteAggregateContext(context.Background())
+}
+
+// ExecuteAggregateContext executes an aggregate query with a context
+func (qb *QueryBuilder) ExecuteAggregateContext(ctx context.Context) (*AggregateResponse, error) {
+	if len(qb.aggregates) == 0 {
+		return nil, &WowMySQLError{Message: "aggregate query requires at least one aggregate"}
+	}
+
+	resp, err := qb.execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := decodeRows[map[string]interface{}](resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AggregateResponse{Rows: make([]AggregateRow, 0, len(data))}
+	for _, row := range data {
+		aggregateRow := AggregateRow{
+			Group:  make(map[string]interface{}, len(qb.groupBy)),
+			Values: make(map[string]AggregateValue, len(qb.aggregates)),
+		}
+		for _, column := range qb.groupBy {
+			aggregateRow.Group[column] = row[column]
+		}
+		for _, aggregate := range qb.aggregates {
+			aggregateRow.Values[aggregate.Alias] = AggregateValue{raw: row[aggregate.Alias]}
+		}
+		result.Rows = append(result.Rows, aggregateRow)
+	}
+
+	return result, nil
+}
+
+// Count returns the number of rows matching the builder's filters. Only the count is
+// transferred; columns, embeds, ordering, limit and offset are ignored.
+func (qb *QueryBuilder) Count(ctx context.Context) (int64, error) {
+	if len(qb.groupBy) > 0 || len(qb.aggregates) > 0 {
+		return 0, &WowMySQLError{Message: "Count cannot be combined with Aggregate or GroupBy; use ExecuteAggregate"}
+	}
+
+	probe := qb.clone()
+	probe.columns = nil
+	probe.embeds = nil
+	probe.order = nil
+	probe.limitValue = nil
+	probe.offsetValue = nil
+	probe.keyset = false
+	probe.aggregates = []Aggregate{Count("*")}
+
+	result, err := probe.ExecuteAggregateContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.Value("count").Int64()
+}
+
+// Exists reports whether any row matches the builder's filters, fetching at most one row.
+// Select a narrow column first to keep the probe small.
+func (qb *QueryBuilder) Exists(ctx context.Context) (bool, error) {
+	probe := qb.clone().Limit(1)
+	probe.embeds = nil
+	probe.order = nil
+	probe.offsetValue = nil
+	probe.keyset = false
+
+	result, err := probe.ExecuteContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Data) > 0, nil
+}
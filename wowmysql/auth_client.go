@@ -2,6 +2,7 @@ package wowmysql
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -22,10 +23,11 @@ type AuthConfig struct {
 
 // AuthClient handles project-level authentication endpoints.
 type AuthClient struct {
-	baseURL     string
-	httpClient  *http.Client
-	publicKey   string
-	accessToken string
+	baseURL      string
+	httpClient   *http.Client
+	options      *clientOptions
+	publicKey    string
+	accessToken  string
 	refreshToken string
 }
 
@@ -92,24 +94,30 @@ type loginResponse struct {
 }
 
 // NewAuthClient constructs a new project auth client.
-func NewAuthClient(config AuthConfig) *AuthClient {
+// Options are applied on top of the config; WithTimeout overrides config.Timeout.
+func NewAuthClient(config AuthConfig, opts ...Option) *AuthClient {
 	base := buildAuthBaseURL(config.ProjectURL, config.BaseDomain, config.Secure)
 	timeout := config.Timeout
 	if timeout == 0 {
 		timeout = 30 * time.Second
 	}
 
+	options := applyOptions(opts)
 	return &AuthClient{
-		baseURL:   base,
-		publicKey: config.PublicAPIKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		baseURL:    base,
+		publicKey:  config.PublicAPIKey,
+		httpClient: options.buildHTTPClient(timeout),
+		options:    options,
 	}
 }
 
 // SignUp registers a new end user for the project.
 func (c *AuthClient) SignUp(email, password string, options ...func(*signUpRequest)) (*AuthResult, error) {
+	return c.SignUpContext(context.Background(), email, password, options...)
+}
+
+// SignUpContext registers a new end user for the project using the provided context.
+func (c *AuthClient) SignUpContext(ctx context.Context, email, password string, options ...func(*signUpRequest)) (*AuthResult, error) {
 	payload := &signUpRequest{
 		Email:    email,
 		Password: password,
@@ -118,7 +126,7 @@ func (c *AuthClient) SignUp(email, password string, options ...func(*signUpReque
 		opt(payload)
 	}
 
-	body, err := c.doRequest("POST", "/signup", payload, nil)
+	body, err := c.doRequest(ctx, "POST", "/signup", payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -158,12 +166,17 @@ func WithUserMetadata(metadata map[string]interface{}) func(*signUpRequest) {
 
 // SignIn authenticates an existing user.
 func (c *AuthClient) SignIn(email, password string) (*AuthResult, error) {
+	return c.SignInContext(context.Background(), email, password)
+}
+
+// SignInContext authenticates an existing user using the provided context.
+func (c *AuthClient) SignInContext(ctx context.Context, email, password string) (*AuthResult, error) {
 	payload := loginRequest{
 		Email:    email,
 		Password: password,
 	}
 
-	body, err := c.doRequest("POST", "/login", payload, nil)
+	body, err := c.doRequest(ctx, "POST", "/login", payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +202,11 @@ func (c *AuthClient) SignIn(email, password string) (*AuthResult, error) {
 
 // GetUser fetches the current user profile using the stored access token.
 func (c *AuthClient) GetUser(tokenOverride ...string) (*AuthUser, error) {
+	return c.GetUserContext(context.Background(), tokenOverride...)
+}
+
+// GetUserContext fetches the current user profile using the provided context.
+func (c *AuthClient) GetUserContext(ctx context.Context, tokenOverride ...string) (*AuthUser, error) {
 	token := c.accessToken
 	if len(tokenOverride) > 0 && tokenOverride[0] != "" {
 		token = tokenOverride[0]
@@ -201,7 +219,7 @@ func (c *AuthClient) GetUser(tokenOverride ...string) (*AuthUser, error) {
 		"Authorization": "Bearer " + token,
 	}
 
-	body, err := c.doRequest("GET", "/me", nil, headers)
+	body, err := c.doRequest(ctx, "GET", "/me", nil, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -216,8 +234,13 @@ func (c *AuthClient) GetUser(tokenOverride ...string) (*AuthUser, error) {
 
 // GetOAuthAuthorizationURL requests the provider authorization URL.
 func (c *AuthClient) GetOAuthAuthorizationURL(provider, redirectURL string) (*OAuthAuthorizeResponse, error) {
+	return c.GetOAuthAuthorizationURLContext(context.Background(), provider, redirectURL)
+}
+
+// GetOAuthAuthorizationURLContext requests the provider authorization URL using the provided context.
+func (c *AuthClient) GetOAuthAuthorizationURLContext(ctx context.Context, provider, redirectURL string) (*OAuthAuthorizeResponse, error) {
 	path := fmt.Sprintf("/oauth/%s?frontend_redirect_uri=%s", provider, url.QueryEscape(redirectURL))
-	body, err := c.doRequest("GET", path, nil, nil)
+	body, err := c.doRequest(ctx, "GET", path, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +257,12 @@ func (c *AuthClient) GetOAuthAuthorizationURL(provider, redirectURL string) (*OA
 // After the user authorizes with the OAuth provider, the provider redirects
 // back with a code. Call this method to exchange that code for JWT tokens.
 func (c *AuthClient) ExchangeOAuthCallback(provider, code string, redirectURI *string) (*AuthResult, error) {
+	return c.ExchangeOAuthCallbackContext(context.Background(), provider, code, redirectURI)
+}
+
+// ExchangeOAuthCallbackContext exchanges OAuth callback code for access tokens
+// using the provided context.
+func (c *AuthClient) ExchangeOAuthCallbackContext(ctx context.Context, provider, code string, redirectURI *string) (*AuthResult, error) {
 	payload := map[string]interface{}{
 		"code": code,
 	}
@@ -241,7 +270,7 @@ func (c *AuthClient) ExchangeOAuthCallback(provider, code string, redirectURI *s
 		payload["redirect_uri"] = *redirectURI
 	}
 
-	body, err := c.doRequest("POST", fmt.Sprintf("/oauth/%s/callback", provider), payload, nil)
+	body, err := c.doRequest(ctx, "POST", fmt.Sprintf("/oauth/%s/callback", provider), payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -269,11 +298,16 @@ func (c *AuthClient) ExchangeOAuthCallback(provider, code string, redirectURI *s
 // Sends a password reset email to the user if they exist.
 // Always returns success to prevent email enumeration.
 func (c *AuthClient) ForgotPassword(email string) (map[string]interface{}, error) {
+	return c.ForgotPasswordContext(context.Background(), email)
+}
+
+// ForgotPasswordContext requests a password reset email using the provided context.
+func (c *AuthClient) ForgotPasswordContext(ctx context.Context, email string) (map[string]interface{}, error) {
 	payload := map[string]interface{}{
 		"email": email,
 	}
 
-	body, err := c.doRequest("POST", "/forgot-password", payload, nil)
+	body, err := c.doRequest(ctx, "POST", "/forgot-password", payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -289,12 +323,17 @@ func (c *AuthClient) ForgotPassword(email string) (map[string]interface{}, error
 // ResetPassword resets password with token.
 // Validates the reset token and updates the user's password.
 func (c *AuthClient) ResetPassword(token, newPassword string) (map[string]interface{}, error) {
+	return c.ResetPasswordContext(context.Background(), token, newPassword)
+}
+
+// ResetPasswordContext resets password with token using the provided context.
+func (c *AuthClient) ResetPasswordContext(ctx context.Context, token, newPassword string) (map[string]interface{}, error) {
 	payload := map[string]interface{}{
 		"token":        token,
 		"new_password": newPassword,
 	}
 
-	body, err := c.doRequest("POST", "/reset-password", payload, nil)
+	body, err := c.doRequest(ctx, "POST", "/reset-password", payload, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +372,7 @@ func (c *AuthClient) persistSession(session AuthSession) {
 	c.refreshToken = session.RefreshToken
 }
 
-func (c *AuthClient) doRequest(method, path string, body interface{}, headers map[string]string) ([]byte, error) {
+func (c *AuthClient) doRequest(ctx context.Context, method, path string, body interface{}, headers map[string]string) ([]byte, error) {
 	var reader io.Reader
 	if body != nil {
 		payload, err := json.Marshal(body)
@@ -344,11 +383,12 @@ func (c *AuthClient) doRequest(method, path string, body interface{}, headers ma
 	}
 
 	url := c.baseURL + path
-	req, err := http.NewRequest(method, url, reader)
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	c.options.setHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 	if c.publicKey != "" {
 		req.Header.Set("X-Wow-Public-Key", c.publicKey)
@@ -357,7 +397,7 @@ func (c *AuthClient) doRequest(method, path string, body interface{}, headers ma
 		req.Header.Set(k, v)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.options.retryPolicyOrNil().send(ctx, httpClientForContext(ctx, c.httpClient), req, isIdempotentMethod(method))
 	if err != nil {
 		return nil, &NetworkError{Err: err}
 	}
@@ -369,7 +409,7 @@ func (c *AuthClient) doRequest(method, path string, body interface{}, headers ma
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, parseError(resp.StatusCode, bodyBytes)
+		return nil, parseError(resp.StatusCode, bodyBytes, resp.Header)
 	}
 
 	return bodyBytes, nil
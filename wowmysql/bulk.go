@@ -0,0 +1,250 @@
+package wowmysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+const (
+	defaultBatchSize   = 500
+	defaultConcurrency = 4
+)
+
+// BulkOption configures a bulk operation
+type BulkOption func(*bulkOptions)
+
+type bulkOptions struct {
+	batchSize   int
+	concurrency int
+}
+
+// WithBatchSize sets how many rows are sent per request (default 500)
+func WithBatchSize(size int) BulkOption {
+	return func(o *bulkOptions) {
+		o.batchSize = size
+	}
+}
+
+// WithConcurrency sets how many batches may be in flight at once (default 4).
+// Inside a transaction batches are always queued one at a time.
+func WithConcurrency(n int) BulkOption {
+	return func(o *bulkOptions) {
+		o.concurrency = n
+	}
+}
+
+// BulkResult reports the outcome of a bulk operation.
+// Inside a transaction rows are only queued, so InsertedIDs and Inserted are nil
+// and AffectedRows is zero; the outcome is known once the transaction commits.
+type BulkResult struct {
+	// InsertedIDs holds the ID of each input row, in input order; failed rows are nil
+	InsertedIDs  []interface{}
+	AffectedRows int
+	// Inserted reports, for upserts, whether each input row was inserted (true)
+	// or updated an existing row (false); it is nil for plain inserts
+	Inserted []bool
+	// Errors lists the rows that failed, in input order
+	Errors []BulkRowError
+
+	rows int
+}
+
+// Succeeded returns the number of rows that did not fail
+func (r *BulkResult) Succeeded() int {
+	return r.rows - len(r.Errors)
+}
+
+// BulkRowError describes a row that failed in a bulk operation
+type BulkRowError struct {
+	Index int
+	Err   error
+}
+
+func (e BulkRowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Index, e.Err.Error())
+}
+
+func (e BulkRowError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError is returned when some rows of a bulk operation failed.
+// Result still reports the rows that succeeded.
+type BulkError struct {
+	Result *BulkResult
+}
+
+func (e *BulkError) Error() string {
+	return fmt.Sprintf("BulkError: %d of %d rows failed: %s",
+		len(e.Result.Errors), e.Result.rows, e.Result.Errors[0].Err.Error())
+}
+
+// Unwrap returns the first row error
+func (e *BulkError) Unwrap() error {
+	return e.Result.Errors[0].Err
+}
+
+// bulkResponse represents a bulk write response.
+// Row indexes in Errors are relative to the batch.
+type bulkResponse struct {
+	IDs          []interface{} `json:"ids"`
+	AffectedRows int           `json:"affected_rows"`
+	Inserted     []bool        `json:"inserted,omitempty"`
+	Success      bool          `json:"success"`
+	Errors       []struct {
+		Index int    `json:"index"`
+		Error string `json:"error"`
+	} `json:"errors,omitempty"`
+}
+
+// InsertMany inserts rows in batches, running batches concurrently.
+// A failed batch does not stop the others; if any row failed the returned error
+// is a *BulkError and the result still lists the rows that succeeded.
+func (t *Table) InsertMany(rows []map[string]interface{}, opts ...BulkOption) (*BulkResult, error) {
+	return t.InsertManyContext(context.Background(), rows, opts...)
+}
+
+// InsertManyContext inserts rows in batches using the provided context
+func (t *Table) InsertManyContext(ctx context.Context, rows []map[string]interface{}, opts ...BulkOption) (*BulkResult, error) {
+	if err := t.validateRows(ctx, rows, nil, nil); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/api/v1/tables/%s/bulk", t.tableName)
+	return t.runBatches(ctx, len(rows), opts, false, func(ctx context.Context, start, end int) (*bulkResponse, error) {
+		return t.sendBatch(ctx, path, map[string]interface{}{
+			"data": rows[start:end],
+		})
+	})
+}
+
+// UpsertMany upserts rows in batches, running batches concurrently.
+// The result's Inserted field reports whether each row was inserted or updated.
+func (t *Table) UpsertMany(rows []map[string]interface{}, conflictColumns, updateColumns []string, opts ...BulkOption) (*BulkResult, error) {
+	return t.UpsertManyContext(context.Background(), rows, conflictColumns, updateColumns, opts...)
+}
+
+// UpsertManyContext upserts rows in batches using the provided context
+func (t *Table) UpsertManyContext(ctx context.Context, rows []map[string]interface{}, conflictColumns, updateColumns []string, opts ...BulkOption) (*BulkResult, error) {
+	if err := t.validateRows(ctx, rows, conflictColumns, updateColumns); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/api/v1/tables/%s/upsert/bulk", t.tableName)
+	return t.runBatches(ctx, len(rows), opts, true, func(ctx context.Context, start, end int) (*bulkResponse, error) {
+		return t.sendBatch(ctx, path, buildUpsertBody(rows[start:end], conflictColumns, updateColumns))
+	})
+}
+
+// sendBatch sends one batch of a bulk operation
+func (t *Table) sendBatch(ctx context.Context, path string, body map[string]interface{}) (*bulkResponse, error) {
+	resp, err := t.doWrite(ctx, "POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result bulkResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &result, nil
+}
+
+// runBatches splits n rows into batches and sends them with bounded concurrency,
+// collecting per-row IDs, insert flags and errors into a BulkResult.
+// Batches of a transaction table are queued one at a time so they keep their input order.
+func (t *Table) runBatches(ctx context.Context, n int, opts []BulkOption, trackInserted bool, send func(ctx context.Context, start, end int) (*bulkResponse, error)) (*BulkResult, error) {
+	options := bulkOptions{
+		batchSize:   defaultBatchSize,
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.batchSize < 1 {
+		options.batchSize = defaultBatchSize
+	}
+	if options.concurrency < 1 || t.tx != nil {
+		options.concurrency = 1
+	}
+
+	// Queued transaction batches only return a placeholder, so their IDs and
+	// insert flags are left nil rather than reporting made-up values
+	queued := t.tx != nil
+	result := &BulkResult{rows: n}
+	if !queued {
+		result.InsertedIDs = make([]interface{}, n)
+		if trackInserted {
+			result.Inserted = make([]bool, n)
+		}
+	}
+	rowErrors := make([]error, n)
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, options.concurrency)
+	)
+batches:
+	for start := 0; start < n; start += options.batchSize {
+		end := start + options.batchSize
+		if end > n {
+			end = n
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for i := start; i < n; i++ {
+				rowErrors[i] = ctx.Err()
+			}
+			break batches
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := send(ctx, start, end)
+			if err != nil {
+				for i := start; i < end; i++ {
+					rowErrors[i] = err
+				}
+				return
+			}
+
+			if queued {
+				return
+			}
+
+			copy(result.InsertedIDs[start:end], resp.IDs)
+			if trackInserted {
+				copy(result.Inserted[start:end], resp.Inserted)
+			}
+			for _, rowErr := range resp.Errors {
+				if i := start + rowErr.Index; i >= start && i < end {
+					rowErrors[i] = &WowMySQLError{Message: rowErr.Error}
+					result.InsertedIDs[i] = nil
+				}
+			}
+			mu.Lock()
+			result.AffectedRows += resp.AffectedRows
+			mu.Unlock()
+		}(start, end)
+	}
+	wg.Wait()
+
+	for i, err := range rowErrors {
+		if err != nil {
+			result.Errors = append(result.Errors, BulkRowError{Index: i, Err: err})
+		}
+	}
+	if len(result.Errors) > 0 {
+		return result, &BulkError{Result: result}
+	}
+
+	return result, nil
+}
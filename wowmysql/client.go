@@ -2,7 +2,9 @@ package wowmysql
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,28 +16,27 @@ type Client struct {
 	projectURL string
 	apiKey     string
 	httpClient *http.Client
+	options    *clientOptions
+	schemas    *schemaCache
 }
 
 // NewClient creates a new WowMySQL client
-func NewClient(projectURL, apiKey string) *Client {
+func NewClient(projectURL, apiKey string, opts ...Option) *Client {
+	options := applyOptions(opts)
 	return &Client{
 		projectURL: projectURL,
 		apiKey:     apiKey,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		httpClient: options.buildHTTPClient(30 * time.Second),
+		options:    options,
+		schemas:    newSchemaCache(options.schemaCacheTTL()),
 	}
 }
 
 // NewClientWithTimeout creates a new WowMySQL client with custom timeout
+//
+// Deprecated: use NewClient with WithTimeout instead.
 func NewClientWithTimeout(projectURL, apiKey string, timeout time.Duration) *Client {
-	return &Client{
-		projectURL: projectURL,
-		apiKey:     apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-	}
+	return NewClient(projectURL, apiKey, WithTimeout(timeout))
 }
 
 // Table returns a new Table instance for the given table name
@@ -48,7 +49,24 @@ func (c *Client) Table(tableName string) *Table {
 
 // ListTables lists all tables in the database
 func (c *Client) ListTables() ([]string, error) {
-	resp, err := c.doRequest("GET", "/api/v1/tables", nil)
+	return c.ListTablesContext(context.Background())
+}
+
+// ListTablesContext lists all tables in the database using the provided context.
+// The list is cached like table schemas (see WithSchemaCacheTTL).
+func (c *Client) ListTablesContext(ctx context.Context) ([]string, error) {
+	tables, err := c.schemas.get(ctx, tablesCacheKey, func(ctx context.Context) (interface{}, error) {
+		return c.fetchTables(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), tables.([]string)...), nil
+}
+
+// fetchTables requests the table list from the server
+func (c *Client) fetchTables(ctx context.Context) ([]string, error) {
+	resp, err := c.doRequest(ctx, "GET", "/api/v1/tables", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +83,36 @@ func (c *Client) ListTables() ([]string, error) {
 
 // GetTableSchema gets the schema information for a table
 func (c *Client) GetTableSchema(tableName string) (*TableSchema, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/api/v1/tables/%s/schema", tableName), nil)
+	return c.GetTableSchemaContext(context.Background(), tableName)
+}
+
+// GetTableSchemaContext gets the schema information for a table using the provided context.
+// Schemas are cached per client (see WithSchemaCacheTTL and InvalidateSchema), and
+// concurrent lookups of the same table share one request. Each call returns a copy
+// the caller may modify.
+func (c *Client) GetTableSchemaContext(ctx context.Context, tableName string) (*TableSchema, error) {
+	schema, err := c.cachedSchema(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return schema.clone(), nil
+}
+
+// cachedSchema returns the cached schema of a table, fetching it when needed.
+// The result is shared with other callers and must not be modified.
+func (c *Client) cachedSchema(ctx context.Context, tableName string) (*TableSchema, error) {
+	schema, err := c.schemas.get(ctx, tableName, func(ctx context.Context) (interface{}, error) {
+		return c.fetchTableSchema(ctx, tableName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schema.(*TableSchema), nil
+}
+
+// fetchTableSchema requests a table schema from the server
+func (c *Client) fetchTableSchema(ctx context.Context, tableName string) (*TableSchema, error) {
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/api/v1/tables/%s/schema", tableName), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -74,21 +121,193 @@ func (c *Client) GetTableSchema(tableName string) (*TableSchema, error) {
 	if err := json.Unmarshal(resp, &schema); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	schema.fillFromTypes()
 
 	return &schema, nil
 }
 
+// QueryParams executes a raw SQL query (read-only) with ? placeholders.
+// Arguments are sent separately from the SQL text in the request's params field.
+func (c *Client) QueryParams(ctx context.Context, sql string, args ...interface{}) ([]map[string]interface{}, error) {
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.query(ctx, sql, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseQueryData(resp)
+}
+
+// QueryNamed executes a raw SQL query (read-only) with :name placeholders
+// whose values come from a map or struct
+func (c *Client) QueryNamed(ctx context.Context, sql string, arg interface{}) ([]map[string]interface{}, error) {
+	bound, args, err := bindNamed(sql, arg)
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryParams(ctx, bound, args...)
+}
+
+// QueryResultSet executes a raw SQL query (read-only) with ? placeholders and returns
+// the column metadata and rows in column order.
+// Column types come from the server's column metadata when it is included in the response.
+func (c *Client) QueryResultSet(ctx context.Context, sql string, args ...interface{}) (*ResultSet, error) {
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.query(ctx, sql, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseResultSet(resp)
+}
+
+// Exec executes a raw write statement (INSERT, UPDATE, DELETE, DDL, CALL, ...) with ? placeholders.
+// The client must be created with WithWriteAccess; read-only API keys get a PermissionError.
+func (c *Client) Exec(ctx context.Context, sql string, args ...interface{}) (*ExecResult, error) {
+	if c.options == nil || !c.options.writeAccess {
+		return nil, &PermissionError{WowMySQLError: WowMySQLError{Message: "Exec requires a client created with WithWriteAccess"}}
+	}
+
+	params, err := encodeParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"sql":         sql,
+		"allow_write": true,
+	}
+
+	if len(params) > 0 {
+		body["params"] = params
+	}
+
+	resp, err := c.doRequest(ctx, "POST", "/api/v1/exec", body)
+	if err != nil {
+		var authErr *AuthenticationError
+		if errors.As(err, &authErr) && authErr.StatusCode == 403 {
+			return nil, &PermissionError{WowMySQLError: authErr.WowMySQLError}
+		}
+		return nil, err
+	}
+
+	var result ExecResult
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // Query executes a raw SQL query (read-only)
 func (c *Client) Query(sql string) ([]map[string]interface{}, error) {
+	return c.QueryContext(context.Background(), sql)
+}
+
+// QueryContext executes a raw SQL query (read-only) using the provided context
+func (c *Client) QueryContext(ctx context.Context, sql string) ([]map[string]interface{}, error) {
+	resp, err := c.query(ctx, sql, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseQueryData(resp)
+}
+
+// query sends a raw SQL query with optional encoded parameters and returns the raw response body
+func (c *Client) query(ctx context.Context, sql string, params []interface{}) ([]byte, error) {
 	body := map[string]interface{}{
 		"sql": sql,
 	}
 
-	resp, err := c.doRequest("POST", "/api/v1/query", body)
+	if len(params) > 0 {
+		body["params"] = params
+	}
+
+	return c.doReadRequest(ctx, "POST", "/api/v1/query", body)
+}
+
+// parseResultSet parses a raw query response into ordered columns and rows.
+// Without column metadata, column order follows the keys of the first row.
+func parseResultSet(resp []byte) (*ResultSet, error) {
+	var result struct {
+		Columns []ColumnInfo      `json:"columns"`
+		Data    []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	columns := result.Columns
+	for i := range columns {
+		columns[i].fillFromType()
+	}
+	if len(columns) == 0 && len(result.Data) > 0 {
+		names, err := objectKeys(result.Data[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		for _, name := range names {
+			columns = append(columns, ColumnInfo{Name: name, Nullable: true})
+		}
+	}
+
+	rows := make([][]interface{}, 0, len(result.Data))
+	for _, raw := range result.Data {
+		var fields map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err := decoder.Decode(&fields); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		row := make([]interface{}, len(columns))
+		for i, column := range columns {
+			row[i] = fields[column.Name]
+		}
+		rows = append(rows, row)
+	}
+
+	return &ResultSet{Columns: columns, Rows: rows}, nil
+}
+
+// objectKeys returns the keys of a JSON object in document order
+func objectKeys(raw json.RawMessage) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	token, err := decoder.Token()
 	if err != nil {
 		return nil, err
 	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object, got %v", token)
+	}
 
+	var keys []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, token.(string))
+
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// parseQueryData parses the data rows of a raw query response
+func parseQueryData(resp []byte) ([]map[string]interface{}, error) {
 	var result struct {
 		Data []map[string]interface{} `json:"data"`
 	}
@@ -101,7 +320,12 @@ func (c *Client) Query(sql string) ([]map[string]interface{}, error) {
 
 // Health checks the API health
 func (c *Client) Health() (map[string]interface{}, error) {
-	resp, err := c.doRequest("GET", "/api/v1/health", nil)
+	return c.HealthContext(context.Background())
+}
+
+// HealthContext checks the API health using the provided context
+func (c *Client) HealthContext(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.doRequest(ctx, "GET", "/api/v1/health", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -114,8 +338,18 @@ func (c *Client) Health() (map[string]interface{}, error) {
 	return result, nil
 }
 
-// doRequest performs an HTTP request
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
+// doRequest performs an HTTP request, retrying it only if the method is idempotent
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	return c.send(ctx, method, path, body, isIdempotentMethod(method))
+}
+
+// doReadRequest performs a read-only HTTP request that is safe to retry regardless of method
+func (c *Client) doReadRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	return c.send(ctx, method, path, body, true)
+}
+
+// send performs an HTTP request
+func (c *Client) send(ctx context.Context, method, path string, body interface{}, idempotent bool) ([]byte, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -126,16 +360,17 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	}
 
 	url := c.projectURL + path
-	req, err := http.NewRequest(method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	c.options.setHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.options.retryPolicyOrNil().send(ctx, httpClientForContext(ctx, c.httpClient), req, idempotent)
 	if err != nil {
 		return nil, &NetworkError{Err: err}
 	}
@@ -147,9 +382,19 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, parseError(resp.StatusCode, respBody)
+		return nil, parseError(resp.StatusCode, respBody, resp.Header)
 	}
 
 	return respBody, nil
 }
 
+// httpClientForContext returns an http.Client that lets a context deadline
+// take priority over the client's fixed Timeout
+func httpClientForContext(ctx context.Context, hc *http.Client) *http.Client {
+	if _, ok := ctx.Deadline(); !ok || hc.Timeout == 0 {
+		return hc
+	}
+	clone := *hc
+	clone.Timeout = 0
+	return &clone
+}
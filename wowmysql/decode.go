@@ -0,0 +1,226 @@
+package wowmysql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ExecuteInto executes the query and decodes the rows into values of type T.
+// Struct fields are matched by their `db` tag, falling back to the `json` tag and field name.
+func ExecuteInto[T any](qb *QueryBuilder) ([]T, error) {
+	return ExecuteIntoContext[T](context.Background(), qb)
+}
+
+// ExecuteIntoContext executes the query using the provided context and decodes the rows into values of type T
+func ExecuteIntoContext[T any](ctx context.Context, qb *QueryBuilder) ([]T, error) {
+	resp, err := qb.execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRows[T](resp)
+}
+
+// FirstInto retrieves only the first result and decodes it into a value of type T.
+// It returns nil if no rows match.
+func FirstInto[T any](qb *QueryBuilder) (*T, error) {
+	return FirstIntoContext[T](context.Background(), qb)
+}
+
+// FirstIntoContext retrieves only the first result using the provided context and decodes it into a value of type T
+func FirstIntoContext[T any](ctx context.Context, qb *QueryBuilder) (*T, error) {
+	rows, err := ExecuteIntoContext[T](ctx, qb.clone().Limit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
+
+// QueryInto executes a raw SQL query (read-only) and decodes the rows into values of type T
+func QueryInto[T any](client *Client, sql string) ([]T, error) {
+	return QueryIntoContext[T](context.Background(), client, sql)
+}
+
+// QueryIntoContext executes a raw SQL query (read-only) using the provided context and decodes the rows into values of type T
+func QueryIntoContext[T any](ctx context.Context, client *Client, sql string) ([]T, error) {
+	resp, err := client.query(ctx, sql, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeRows[T](resp)
+}
+
+// decodeRows decodes the data rows of a response body into values of type T.
+// Numbers are decoded with json.Number so integers keep their full precision.
+func decodeRows[T any](body []byte) ([]T, error) {
+	var envelope struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	plan := buildDecodePlan(reflect.TypeOf((*T)(nil)).Elem(), make(map[reflect.Type]*decodePlan))
+	rows := make([]T, 0, len(envelope.Data))
+	for i, raw := range envelope.Data {
+		var row T
+		if err := decodeRow(raw, plan, &row); err != nil {
+			return nil, fmt.Errorf("failed to decode row %d: %w", i, err)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// decodeRow renames columns to their JSON keys and decodes a single row into dst
+func decodeRow(raw json.RawMessage, plan *decodePlan, dst interface{}) error {
+	if plan != nil {
+		renamed, err := plan.rename(raw)
+		if err != nil {
+			return err
+		}
+		raw = renamed
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	return decoder.Decode(dst)
+}
+
+// decodePlan describes how the `db` column names of a struct type, including the
+// structs of embedded relations nested in it, map onto its JSON keys
+type decodePlan struct {
+	columns map[string]string      // db column -> JSON key
+	nested  map[string]*decodePlan // JSON key -> plan for a nested struct or slice of structs
+}
+
+// buildDecodePlan returns the plan for t, or nil if its rows can be decoded as-is
+func buildDecodePlan(t reflect.Type, seen map[reflect.Type]*decodePlan) *decodePlan {
+	t = structType(t)
+	if t == nil {
+		return nil
+	}
+	if plan, ok := seen[t]; ok {
+		return plan
+	}
+
+	plan := &decodePlan{
+		columns: make(map[string]string),
+		nested:  make(map[string]*decodePlan),
+	}
+	seen[t] = plan
+	plan.collect(t, seen)
+
+	if len(plan.columns) == 0 && len(plan.nested) == 0 {
+		seen[t] = nil
+		return nil
+	}
+	return plan
+}
+
+// collect adds the fields of struct type t, flattening anonymous structs like encoding/json
+func (p *decodePlan) collect(t reflect.Type, seen map[reflect.Type]*decodePlan) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if jsonName == "-" {
+			continue
+		}
+
+		if field.Anonymous && jsonName == "" {
+			if embedded := structType(field.Type); embedded != nil {
+				p.collect(embedded, seen)
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		column, _, _ := strings.Cut(field.Tag.Get("db"), ",")
+		if column != "" && column != "-" && column != jsonName {
+			p.columns[column] = jsonName
+		}
+
+		if nested := buildDecodePlan(field.Type, seen); nested != nil {
+			p.nested[jsonName] = nested
+		}
+	}
+}
+
+// rename rewrites the keys of a JSON object, or of each object in a JSON array
+func (p *decodePlan) rename(raw json.RawMessage) (json.RawMessage, error) {
+	switch firstByte(raw) {
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		for i, item := range items {
+			renamed, err := p.rename(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = renamed
+		}
+		return json.Marshal(items)
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		for column, key := range p.columns {
+			if value, ok := fields[column]; ok {
+				delete(fields, column)
+				fields[key] = value
+			}
+		}
+		for key, nested := range p.nested {
+			if value, ok := fields[key]; ok {
+				renamed, err := nested.rename(value)
+				if err != nil {
+					return nil, err
+				}
+				fields[key] = renamed
+			}
+		}
+		return json.Marshal(fields)
+	default:
+		return raw, nil
+	}
+}
+
+// structType returns the struct type behind pointers, slices and arrays, or nil.
+// Types with their own JSON decoding, such as time.Time, are left alone.
+func structType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+	return t
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// firstByte returns the first non-whitespace byte of a JSON value
+func firstByte(raw json.RawMessage) byte {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	if len(trimmed) == 0 {
+		return 0
+	}
+	return trimmed[0]
+}
@@ -0,0 +1,124 @@
+package wowmysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// JoinKind represents the type of an SQL join
+type JoinKind string
+
+const (
+	JoinInner JoinKind = "inner"
+	JoinLeft  JoinKind = "left"
+	JoinRight JoinKind = "right"
+)
+
+// embedding is a related table whose rows are nested into each result row
+type embedding struct {
+	Relation      string   `json:"relation"`
+	Columns       []string `json:"columns,omitempty"`
+	LocalColumn   string   `json:"local_column,omitempty"`
+	ForeignColumn string   `json:"foreign_column,omitempty"`
+	Many          bool     `json:"many"`
+}
+
+// joinClause is an explicit join; On is parsed when the request body is built
+type joinClause struct {
+	Table string
+	On    string
+	Kind  JoinKind
+}
+
+// joinSpec is the wire format of a join
+type joinSpec struct {
+	Table string   `json:"table"`
+	Kind  JoinKind `json:"kind"`
+	Left  string   `json:"left"`
+	Right string   `json:"right"`
+}
+
+// Embed nests rows of a related table into each result under the table's name.
+// The relationship is resolved from the foreign keys in the table schemas: a foreign
+// key on this table embeds the referenced row as an object, and a foreign key on the
+// related table embeds the referencing rows as an array.
+//
+//	users, err := wowmysql.ExecuteInto[User](
+//		client.Table("users").Select("id", "name").Embed("orders", "id", "total"),
+//	)
+func (qb *QueryBuilder) Embed(table string, columns ...string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.embeds = append(qb.embeds, embedding{Relation: table, Columns: columns})
+	return qb
+}
+
+// Join joins another table on a column equality such as "orders.user_id = users.id".
+// Joined columns are returned flat; qualify them in Select to avoid name clashes.
+func (qb *QueryBuilder) Join(table, on string, kind JoinKind) *QueryBuilder {
+	qb = qb.mutable()
+	qb.joins = append(qb.joins, joinClause{Table: table, On: on, Kind: kind})
+	return qb
+}
+
+// resolveEmbeds fills in the join columns of each embedding from the table schemas
+func (qb *QueryBuilder) resolveEmbeds(ctx context.Context) error {
+	for i := range qb.embeds {
+		embed := &qb.embeds[i]
+		if embed.LocalColumn != "" {
+			continue
+		}
+
+		own, err := qb.client.cachedSchema(ctx, qb.tableName)
+		if err != nil {
+			return err
+		}
+		related, err := qb.client.cachedSchema(ctx, embed.Relation)
+		if err != nil {
+			return err
+		}
+
+		var candidates []embedding
+		for _, fk := range own.ForeignKeys {
+			if fk.ReferencedTable == embed.Relation {
+				candidates = append(candidates, embedding{LocalColumn: fk.Column, ForeignColumn: fk.ReferencedColumn})
+			}
+		}
+		for _, fk := range related.ForeignKeys {
+			if fk.ReferencedTable == qb.tableName {
+				candidates = append(candidates, embedding{LocalColumn: fk.ReferencedColumn, ForeignColumn: fk.Column, Many: true})
+			}
+		}
+
+		switch len(candidates) {
+		case 0:
+			return &WowMySQLError{Message: fmt.Sprintf("no foreign key between %s and %s; use Join", qb.tableName, embed.Relation)}
+		case 1:
+			embed.LocalColumn = candidates[0].LocalColumn
+			embed.ForeignColumn = candidates[0].ForeignColumn
+			embed.Many = candidates[0].Many
+		default:
+			return &WowMySQLError{Message: fmt.Sprintf("multiple foreign keys between %s and %s; use Join", qb.tableName, embed.Relation)}
+		}
+	}
+	return nil
+}
+
+// joinSpecs converts the builder's joins into their wire format
+func (qb *QueryBuilder) joinSpecs() ([]joinSpec, error) {
+	specs := make([]joinSpec, 0, len(qb.joins))
+	for _, join := range qb.joins {
+		left, right, ok := strings.Cut(join.On, "=")
+		left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+		if !ok || left == "" || right == "" || strings.Contains(right, "=") {
+			return nil, &WowMySQLError{Message: fmt.Sprintf("invalid join condition %q; expected \"left_column = right_column\"", join.On)}
+		}
+
+		kind := join.Kind
+		if kind == "" {
+			kind = JoinInner
+		}
+		specs = append(specs, joinSpec{Table: join.Table, Kind: kind, Left: left, Right: right})
+	}
+	return specs, nil
+}
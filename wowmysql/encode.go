@@ -0,0 +1,87 @@
+package wowmysql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structToMap converts a struct (or pointer to struct) into column values.
+// Column names come from the `db` tag, falling back to the `json` tag and field name.
+// Fields tagged "-" are ignored and `omitempty` fields are skipped when zero.
+func structToMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %T", v)
+	}
+
+	data := make(map[string]interface{})
+	collectColumns(rv, data, true)
+	return data, nil
+}
+
+// collectColumns adds the column values of a struct value to data, flattening embedded structs.
+// When skipEmpty is set, zero `omitempty` fields are left out.
+func collectColumns(rv reflect.Value, data map[string]interface{}, skipEmpty bool) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		column, omitEmpty, skip := columnTag(field)
+		if skip {
+			continue
+		}
+
+		value := rv.Field(i)
+		if field.Anonymous && column == "" {
+			nilStruct := false
+			for value.Kind() == reflect.Ptr {
+				if value.IsNil() {
+					nilStruct = value.Type().Elem().Kind() == reflect.Struct
+					break
+				}
+				value = value.Elem()
+			}
+			// Like encoding/json, a nil embedded struct pointer contributes no columns
+			if nilStruct {
+				continue
+			}
+			if value.Kind() == reflect.Struct {
+				collectColumns(value, data, skipEmpty)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if skipEmpty && omitEmpty && value.IsZero() {
+			continue
+		}
+		if column == "" {
+			column = field.Name
+		}
+		data[column] = value.Interface()
+	}
+}
+
+// columnTag reads the column name and options of a struct field from its `db` or `json` tag
+func columnTag(field reflect.StructField) (column string, omitEmpty bool, skip bool) {
+	tag, ok := field.Tag.Lookup("db")
+	if !ok {
+		tag = field.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+
+	column, opts, _ := strings.Cut(tag, ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return column, omitEmpty, false
+}
@@ -3,6 +3,8 @@ package wowmysql
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 // WowMySQLError represents a base WowMySQL error
@@ -24,6 +26,25 @@ type AuthenticationError struct {
 	WowMySQLError
 }
 
+// PermissionError represents operations the client or API key is not allowed to perform
+type PermissionError struct {
+	WowMySQLError
+}
+
+// ValidationError is returned before sending a request when client-side validation
+// (WithValidation) finds columns or operators that do not match the table schema
+type ValidationError struct {
+	WowMySQLError
+	Table  string
+	Fields []FieldError
+}
+
+// FieldError describes one invalid column in a request
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
 // NotFoundError represents not found errors
 type NotFoundError struct {
 	WowMySQLError
@@ -32,6 +53,8 @@ type NotFoundError struct {
 // RateLimitError represents rate limit errors
 type RateLimitError struct {
 	WowMySQLError
+	// RetryAfter is the delay requested by the server's Retry-After header, if any
+	RetryAfter time.Duration
 }
 
 // NetworkError represents network errors
@@ -89,7 +112,7 @@ func (e *StorageLimitExceededError) Error() string {
 }
 
 // parseError parses an error response
-func parseError(statusCode int, body []byte) error {
+func parseError(statusCode int, body []byte, header http.Header) error {
 	var errorResponse map[string]interface{}
 	_ = json.Unmarshal(body, &errorResponse)
 
@@ -130,6 +153,7 @@ func parseError(statusCode int, body []byte) error {
 				StatusCode: statusCode,
 				Response:   errorResponse,
 			},
+			RetryAfter: parseRetryAfter(header),
 		}
 	default:
 		return &WowMySQLError{
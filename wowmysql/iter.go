@@ -0,0 +1,111 @@
+package wowmysql
+
+import (
+	"context"
+	"iter"
+)
+
+// defaultPageSize is used by Iter and IterInto when pageSize is not positive
+const defaultPageSize = 100
+
+// Iter returns an iterator over all rows matching the query, fetching pageSize rows
+// per request as the loop advances. Breaking out of the loop stops further requests.
+// An existing Limit caps the total number of rows and Offset sets the starting row;
+// in keyset mode (Cursor or After) pages follow NextCursor instead of an offset.
+// The query must be ordered with OrderBy, ideally ending with a unique column, so that
+// pages do not skip or repeat rows; otherwise the first iteration yields an error.
+//
+//	for row, err := range client.Table("users").Select("*").OrderBy("id", wowmysql.SortAsc).Iter(ctx, 500) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (qb *QueryBuilder) Iter(ctx context.Context, pageSize int) iter.Seq2[map[string]interface{}, error] {
+	return paginate(ctx, qb, pageSize, func(ctx context.Context, page *QueryBuilder) ([]map[string]interface{}, string, error) {
+		result, err := page.ExecuteContext(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Data, result.NextCursor, nil
+	})
+}
+
+// IterInto is like QueryBuilder.Iter but decodes each row into a value of type T
+func IterInto[T any](ctx context.Context, qb *QueryBuilder, pageSize int) iter.Seq2[T, error] {
+	return paginate(ctx, qb, pageSize, func(ctx context.Context, page *QueryBuilder) ([]T, string, error) {
+		resp, err := page.execute(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		rows, err := decodeRows[T](resp)
+		if err != nil || !page.keyset {
+			return rows, "", err
+		}
+		next, err := page.nextCursor(resp)
+		return rows, next, err
+	})
+}
+
+// paginate fetches pages lazily and yields their rows one by one. Pages are selected
+// with Limit/Offset, or with Limit/After in keyset mode; fetch returns a page's rows
+// and, in keyset mode, the cursor of the following page.
+func paginate[T any](ctx context.Context, qb *QueryBuilder, pageSize int, fetch func(context.Context, *QueryBuilder) ([]T, string, error)) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		if len(qb.order) == 0 {
+			var zero T
+			yield(zero, &WowMySQLError{Message: "iterating over a query requires OrderBy so that pages are stable"})
+			return
+		}
+
+		offset := 0
+		if qb.offsetValue != nil {
+			offset = *qb.offsetValue
+		}
+		cursor := qb.afterCursor
+		remaining := -1
+		if qb.limitValue != nil {
+			remaining = *qb.limitValue
+		}
+
+		for remaining != 0 {
+			size := pageSize
+			if remaining > 0 && remaining < size {
+				size = remaining
+			}
+
+			page := qb.clone().Limit(size)
+			if qb.keyset {
+				page = page.After(cursor)
+			} else {
+				page = page.Offset(offset)
+			}
+
+			rows, next, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			if len(rows) < size || qb.keyset && next == "" {
+				return
+			}
+			offset += len(rows)
+			cursor = next
+			if remaining > 0 {
+				remaining -= len(rows)
+			}
+		}
+	}
+}
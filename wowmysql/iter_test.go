@@ -0,0 +1,85 @@
+package wowmysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newKeysetTestClient returns a client backed by a server holding rows with ids 1..total,
+// answering queries ordered by id with an optional `id > n` cursor filter
+func newKeysetTestClient(t *testing.T, total int) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Filters []FilterExpression `json:"filters"`
+			Limit   *int               `json:"limit"`
+			Offset  *int               `json:"offset"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body.Offset != nil || body.Limit == nil {
+			http.Error(w, "keyset page sent offset or no limit", http.StatusBadRequest)
+			return
+		}
+
+		after := 0
+		for _, filter := range body.Filters {
+			if filter.Column == "id" && filter.Operator == OpGt {
+				after = int(filter.Value.(float64))
+			}
+		}
+
+		rows := make([]map[string]interface{}, 0, *body.Limit)
+		for id := after + 1; id <= total && len(rows) < *body.Limit; id++ {
+			rows = append(rows, map[string]interface{}{"id": id})
+		}
+		data, _ := json.Marshal(rows)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":%s,"count":%d}`, data, len(rows))
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient(srv.URL, "test-key")
+}
+
+func TestIterKeyset(t *testing.T) {
+	client := newKeysetTestClient(t, 25)
+	ctx := context.Background()
+	qb := client.Table("users").Select("*").OrderBy("id", SortAsc).Cursor()
+
+	var ids []int
+	for row, err := range qb.Iter(ctx, 10) {
+		if err != nil {
+			t.Fatalf("Iter: %v", err)
+		}
+		id, _ := row["id"].(float64)
+		ids = append(ids, int(id))
+	}
+	if len(ids) != 25 || ids[0] != 1 || ids[24] != 25 {
+		t.Errorf("Iter yielded ids %v, want 1..25", ids)
+	}
+
+	type user struct {
+		ID int `json:"id"`
+	}
+	count := 0
+	for u, err := range IterInto[user](ctx, qb.Clone().Limit(12), 5) {
+		if err != nil {
+			t.Fatalf("IterInto: %v", err)
+		}
+		count++
+		if u.ID != count {
+			t.Fatalf("IterInto yielded id %d at position %d", u.ID, count)
+		}
+	}
+	if count != 12 {
+		t.Errorf("IterInto yielded %d rows, want 12", count)
+	}
+}
@@ -0,0 +1,191 @@
+package wowmysql
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// keysetCursor is the decoded form of an opaque pagination cursor
+type keysetCursor struct {
+	Columns []string      `json:"k"`
+	Values  []interface{} `json:"v"`
+}
+
+// Cursor switches the query to keyset pagination starting at the first page.
+// The query must be ordered with OrderBy and limited with Limit; each result then
+// carries a NextCursor to pass to After for the following page. Order by a unique
+// column (or end with one as a tie-breaker) so that no rows are skipped. NULL sort
+// values follow MySQL's default placement (first ascending, last descending); explicit
+// NULLS FIRST/LAST placement and Offset are not supported.
+func (qb *QueryBuilder) Cursor() *QueryBuilder {
+	qb = qb.mutable()
+	qb.keyset = true
+	return qb
+}
+
+// After switches the query to keyset pagination, continuing after the row the
+// cursor was taken from. An empty cursor starts at the first page.
+//
+//	page, err := users.OrderBy("id", wowmysql.SortAsc).Limit(50).After(r.URL.Query().Get("cursor")).Execute()
+//	// hand page.NextCursor back to the client
+func (qb *QueryBuilder) After(cursor string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.keyset = true
+	qb.afterCursor = cursor
+	return qb
+}
+
+// cursorFilter translates the After cursor into a filter selecting the rows that
+// follow it in sort order: (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ...
+func (qb *QueryBuilder) cursorFilter() (*FilterExpression, error) {
+	keys := qb.order
+	if len(keys) == 0 {
+		return nil, &WowMySQLError{Message: "keyset pagination requires OrderBy"}
+	}
+	if qb.limitValue == nil {
+		return nil, &WowMySQLError{Message: "keyset pagination requires Limit"}
+	}
+	if qb.offsetValue != nil {
+		return nil, &WowMySQLError{Message: "keyset pagination does not support Offset"}
+	}
+	for _, key := range keys {
+		if key.Nulls != NullsDefault {
+			return nil, &WowMySQLError{Message: fmt.Sprintf("keyset pagination does not support NULLS FIRST/LAST on sort column %s", key.Column)}
+		}
+	}
+	if qb.afterCursor == "" {
+		return nil, nil
+	}
+
+	cursor, err := decodeCursor(qb.afterCursor)
+	if err != nil {
+		return nil, err
+	}
+	if len(cursor.Columns) != len(keys) || len(cursor.Values) != len(keys) {
+		return nil, &WowMySQLError{Message: "cursor does not match the query's ordering"}
+	}
+	for i, key := range keys {
+		if cursor.Columns[i] != key.Column {
+			return nil, &WowMySQLError{Message: "cursor does not match the query's ordering"}
+		}
+	}
+
+	branches := make([]FilterExpression, 0, len(keys))
+	for i, key := range keys {
+		after, ok := keyAfter(key, cursor.Values[i])
+		if !ok {
+			continue
+		}
+
+		branch := make([]FilterExpression, 0, i+1)
+		for j := 0; j < i; j++ {
+			branch = append(branch, keyEqual(keys[j].Column, cursor.Values[j]))
+		}
+		branch = append(branch, after)
+
+		if len(branch) == 1 {
+			branches = append(branches, branch[0])
+		} else {
+			branches = append(branches, FilterExpression{Logic: LogicAnd, Filters: branch})
+		}
+	}
+
+	switch len(branches) {
+	case 0:
+		// Every key is NULL at its last position, so no row can follow the cursor
+		return &FilterExpression{Logic: LogicAnd, Filters: []FilterExpression{
+			{Column: keys[0].Column, Operator: OpIsNull},
+			{Column: keys[0].Column, Operator: OpIsNotNull},
+		}}, nil
+	case 1:
+		return &branches[0], nil
+	}
+	return &FilterExpression{Logic: LogicOr, Filters: branches}, nil
+}
+
+// keyEqual matches rows whose sort column equals the cursor value, treating NULL as a value
+func keyEqual(column string, value interface{}) FilterExpression {
+	if value == nil {
+		return FilterExpression{Column: column, Operator: OpIsNull}
+	}
+	return FilterExpression{Column: column, Operator: OpEq, Value: value}
+}
+
+// keyAfter matches rows whose sort column comes strictly after the cursor value.
+// MySQL sorts NULLs first in ascending and last in descending order, so NULLs follow
+// any value in descending order and any non-NULL value follows a NULL in ascending
+// order. ok is false when nothing can follow, i.e. after a NULL in descending order.
+func keyAfter(key SortKey, value interface{}) (filter FilterExpression, ok bool) {
+	switch {
+	case key.Direction == SortDesc && value == nil:
+		return FilterExpression{}, false
+	case key.Direction == SortDesc:
+		return FilterExpression{Logic: LogicOr, Filters: []FilterExpression{
+			{Column: key.Column, Operator: OpLt, Value: value},
+			{Column: key.Column, Operator: OpIsNull},
+		}}, true
+	case value == nil:
+		return FilterExpression{Column: key.Column, Operator: OpIsNotNull}, true
+	default:
+		return FilterExpression{Column: key.Column, Operator: OpGt, Value: value}, true
+	}
+}
+
+// nextCursor builds the cursor for the page after the given response body.
+// It is empty when the page was not full, since no further rows can follow.
+func (qb *QueryBuilder) nextCursor(body []byte) (string, error) {
+	var envelope struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(envelope.Data) == 0 || len(envelope.Data) < *qb.limitValue {
+		return "", nil
+	}
+
+	var last map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(envelope.Data[len(envelope.Data)-1]))
+	decoder.UseNumber()
+	if err := decoder.Decode(&last); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	keys := qb.order
+	cursor := keysetCursor{
+		Columns: make([]string, len(keys)),
+		Values:  make([]interface{}, len(keys)),
+	}
+	for i, key := range keys {
+		value, ok := last[key.Column]
+		if !ok {
+			return "", &WowMySQLError{Message: fmt.Sprintf("keyset pagination requires the sort column %s to be selected", key.Column)}
+		}
+		cursor.Columns[i] = key.Column
+		cursor.Values[i] = value
+	}
+
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodeCursor decodes an opaque cursor produced by nextCursor
+func decodeCursor(cursor string) (*keysetCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, &WowMySQLError{Message: "invalid cursor"}
+	}
+
+	var decoded keysetCursor
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, &WowMySQLError{Message: "invalid cursor"}
+	}
+	return &decoded, nil
+}
@@ -8,6 +8,8 @@ type QueryResponse struct {
 	Count int                      `json:"count"`
 	Total *int                     `json:"total,omitempty"`
 	Error *string                  `json:"error,omitempty"`
+	// NextCursor is set in keyset mode (Cursor or After) when another page may follow
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // CreateResponse represents a create operation response
@@ -23,26 +25,76 @@ type UpdateResponse struct {
 	Success      bool `json:"success"`
 }
 
+// UpsertResponse represents an upsert operation response
+type UpsertResponse struct {
+	ID           interface{} `json:"id"`
+	AffectedRows int         `json:"affected_rows"`
+	Inserted     bool        `json:"inserted"`
+	Success      bool        `json:"success"`
+}
+
 // DeleteResponse represents a delete operation response
 type DeleteResponse struct {
 	AffectedRows int  `json:"affected_rows"`
 	Success      bool `json:"success"`
 }
 
+// ResultSet represents a raw query result with rows in column order.
+// Numbers are decoded as json.Number to keep their full precision.
+type ResultSet struct {
+	Columns []ColumnInfo
+	Rows    [][]interface{}
+}
+
+// ExecResult represents the result of a raw write statement
+type ExecResult struct {
+	RowsAffected int64 `json:"affected_rows"`
+	LastInsertID int64 `json:"last_insert_id"`
+	Success      bool  `json:"success"`
+}
+
 // TableSchema represents table schema information
 type TableSchema struct {
-	Name       string       `json:"name"`
-	Columns    []ColumnInfo `json:"columns"`
-	PrimaryKey *string      `json:"primary_key,omitempty"`
-	RowCount   *int         `json:"row_count,omitempty"`
+	Name        string       `json:"name"`
+	Columns     []ColumnInfo `json:"columns"`
+	PrimaryKey  *string      `json:"primary_key,omitempty"`
+	RowCount    *int         `json:"row_count,omitempty"`
+	Indexes     []IndexInfo  `json:"indexes,omitempty"`
+	ForeignKeys []ForeignKey `json:"foreign_keys,omitempty"`
+	Comment     string       `json:"comment,omitempty"`
+}
+
+// IndexInfo represents an index; Columns are in index order
+type IndexInfo struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique"`
+	Primary bool     `json:"primary"`
 }
 
-// ColumnInfo represents column information
+// ForeignKey represents a foreign key column and the column it references
+type ForeignKey struct {
+	Name             string `json:"name,omitempty"`
+	Column           string `json:"column"`
+	ReferencedTable  string `json:"referenced_table"`
+	ReferencedColumn string `json:"referenced_column"`
+}
+
+// ColumnInfo represents column information.
+// Length, precision, scale and enum values are parsed from Type when the server omits them.
 type ColumnInfo struct {
 	Name     string      `json:"name"`
 	Type     string      `json:"type"`
 	Nullable bool        `json:"nullable"`
 	Default  interface{} `json:"default,omitempty"`
+
+	AutoIncrement    bool     `json:"auto_increment,omitempty"`
+	Unsigned         bool     `json:"unsigned,omitempty"`
+	MaxLength        *int64   `json:"character_maximum_length,omitempty"`
+	NumericPrecision *int     `json:"numeric_precision,omitempty"`
+	NumericScale     *int     `json:"numeric_scale,omitempty"`
+	EnumValues       []string `json:"enum_values,omitempty"` // allowed values of ENUM and SET columns
+	Comment          string   `json:"comment,omitempty"`
 }
 
 // StorageQuota represents storage quota information
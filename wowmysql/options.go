@@ -0,0 +1,150 @@
+package wowmysql
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client, StorageClient or AuthClient
+type Option func(*clientOptions)
+
+// clientOptions holds the settings collected from Option values
+type clientOptions struct {
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     *time.Duration
+	userAgent   string
+	baseHeaders map[string]string
+	retryPolicy *RetryPolicy
+	writeAccess bool
+	validate    bool
+	schemaTTL   *time.Duration
+}
+
+// WithHTTPClient uses the given http.Client for all requests.
+// WithTimeout and WithTransport still apply on top of it without modifying the original.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the http.RoundTripper used for requests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout sets the HTTP client timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = &timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithBaseHeaders sets headers sent with every request.
+// Headers managed by the SDK (Content-Type, Authorization, ...) take precedence.
+func WithBaseHeaders(headers map[string]string) Option {
+	return func(o *clientOptions) {
+		if o.baseHeaders == nil {
+			o.baseHeaders = make(map[string]string, len(headers))
+		}
+		for k, v := range headers {
+			o.baseHeaders[k] = v
+		}
+	}
+}
+
+// WithValidation checks query columns, filter operators and written columns against
+// the cached table schema before sending requests, returning a ValidationError that
+// lists every problem instead of a server error. It costs one schema request per table.
+func WithValidation() Option {
+	return func(o *clientOptions) {
+		o.validate = true
+	}
+}
+
+// WithSchemaCacheTTL sets how long table schemas and the table list are cached
+// (5 minutes by default). A TTL of zero or less disables caching; concurrent lookups
+// of the same schema still share one request.
+func WithSchemaCacheTTL(ttl time.Duration) Option {
+	return func(o *clientOptions) {
+		o.schemaTTL = &ttl
+	}
+}
+
+// WithWriteAccess allows Client.Exec to run raw write statements (DML and DDL).
+// Without it Exec returns a PermissionError before sending anything.
+func WithWriteAccess() Option {
+	return func(o *clientOptions) {
+		o.writeAccess = true
+	}
+}
+
+// applyOptions collects the given options
+func applyOptions(opts []Option) *clientOptions {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// buildHTTPClient returns the http.Client described by the options,
+// falling back to a new client with the given default timeout
+func (o *clientOptions) buildHTTPClient(defaultTimeout time.Duration) *http.Client {
+	if o.httpClient == nil {
+		httpClient := &http.Client{Timeout: defaultTimeout}
+		if o.timeout != nil {
+			httpClient.Timeout = *o.timeout
+		}
+		if o.transport != nil {
+			httpClient.Transport = o.transport
+		}
+		return httpClient
+	}
+
+	if o.timeout == nil && o.transport == nil {
+		return o.httpClient
+	}
+
+	httpClient := *o.httpClient
+	if o.timeout != nil {
+		httpClient.Timeout = *o.timeout
+	}
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+	return &httpClient
+}
+
+// setHeaders applies the configured User-Agent and base headers to a request
+func (o *clientOptions) setHeaders(req *http.Request) {
+	if o == nil {
+		return
+	}
+	for k, v := range o.baseHeaders {
+		req.Header.Set(k, v)
+	}
+	if o.userAgent != "" {
+		req.Header.Set("User-Agent", o.userAgent)
+	}
+}
+
+// schemaCacheTTL returns the configured schema cache TTL or the default
+func (o *clientOptions) schemaCacheTTL() time.Duration {
+	if o.schemaTTL != nil {
+		return *o.schemaTTL
+	}
+	return defaultSchemaCacheTTL
+}
@@ -0,0 +1,186 @@
+package wowmysql
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// mysqlDateTimeFormat is the layout used to send time.Time parameters
+const mysqlDateTimeFormat = "2006-01-02 15:04:05.999999"
+
+// encodeParams converts Go values into their JSON wire representation
+func encodeParams(args []interface{}) ([]interface{}, error) {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := encodeParam(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode parameter %d: %w", i+1, err)
+		}
+		params[i] = value
+	}
+	return params, nil
+}
+
+// encodeParam converts a single parameter value.
+// time.Time is sent as a UTC MySQL DATETIME string, []byte as {"type": "binary", "value": <base64>},
+// nil and nil pointers as null, and driver.Valuer values through their Value method.
+func encodeParam(arg interface{}) (interface{}, error) {
+	if valuer, ok := arg.(driver.Valuer); ok {
+		rv := reflect.ValueOf(arg)
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, nil
+		}
+		value, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+		arg = value
+	}
+
+	switch v := arg.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return v.UTC().Format(mysqlDateTimeFormat), nil
+	case []byte:
+		if v == nil {
+			return nil, nil
+		}
+		return map[string]interface{}{
+			"type":  "binary",
+			"value": base64.StdEncoding.EncodeToString(v),
+		}, nil
+	}
+
+	rv := reflect.ValueOf(arg)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return encodeParam(rv.Elem().Interface())
+	}
+	return arg, nil
+}
+
+// bindNamed rewrites :name placeholders to ? and returns the matching positional arguments.
+// arg may be a map with string keys or a struct (columns resolved like structToMap).
+// Placeholders inside quoted strings, comments and :: casts are left untouched.
+func bindNamed(sql string, arg interface{}) (string, []interface{}, error) {
+	values, err := namedValues(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		out   strings.Builder
+		args  []interface{}
+		quote byte
+	)
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+
+		if quote != 0 {
+			out.WriteByte(c)
+			if c == '\\' && i+1 < len(sql) {
+				i++
+				out.WriteByte(sql[i])
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		if end := commentEnd(sql, i); end > i {
+			out.WriteString(sql[i:end])
+			i = end - 1
+			continue
+		}
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			out.WriteByte(c)
+		case c == ':' && i+1 < len(sql) && sql[i+1] == ':':
+			out.WriteString("::")
+			i++
+		case c == ':' && i+1 < len(sql) && isIdentStart(sql[i+1]):
+			j := i + 1
+			for j < len(sql) && isIdentChar(sql[j]) {
+				j++
+			}
+			name := sql[i+1 : j]
+			value, ok := values[name]
+			if !ok {
+				return "", nil, fmt.Errorf("missing value for named parameter :%s", name)
+			}
+			args = append(args, value)
+			out.WriteByte('?')
+			i = j - 1
+		default:
+			out.WriteByte(c)
+		}
+	}
+
+	return out.String(), args, nil
+}
+
+// namedValues returns the named parameter values of a map or struct
+func namedValues(arg interface{}) (map[string]interface{}, error) {
+	if m, ok := arg.(map[string]interface{}); ok {
+		return m, nil
+	}
+
+	rv := reflect.ValueOf(arg)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("named parameters require string map keys, got %T", arg)
+		}
+		values := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			values[iter.Key().String()] = iter.Value().Interface()
+		}
+		return values, nil
+	case reflect.Struct:
+		values := make(map[string]interface{})
+		collectColumns(rv, values, false)
+		return values, nil
+	default:
+		return nil, fmt.Errorf("named parameters require a map or struct, got %T", arg)
+	}
+}
+
+// commentEnd returns the index just past a MySQL comment starting at i ("-- ", "#" or
+// "/* */"), or i when no comment starts there. Unterminated comments run to the end.
+func commentEnd(sql string, i int) int {
+	switch {
+	case sql[i] == '#',
+		strings.HasPrefix(sql[i:], "--") && (i+2 == len(sql) || strings.IndexByte(" \t\r\n", sql[i+2]) >= 0):
+		if j := strings.IndexByte(sql[i:], '\n'); j >= 0 {
+			return i + j + 1
+		}
+		return len(sql)
+	case strings.HasPrefix(sql[i:], "/*"):
+		if j := strings.Index(sql[i+2:], "*/"); j >= 0 {
+			return i + 2 + j + 2
+		}
+		return len(sql)
+	}
+	return i
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
@@ -1,8 +1,10 @@
 package wowmysql
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 // FilterOperator represents a query filter operator
@@ -17,6 +19,13 @@ const (
 	OpLte    FilterOperator = "lte"
 	OpLike   FilterOperator = "like"
 	OpIsNull FilterOperator = "is"
+
+	OpIn        FilterOperator = "in"
+	OpNotIn     FilterOperator = "not_in"
+	OpBetween   FilterOperator = "between"
+	OpIsNotNull FilterOperator = "is_not"
+	OpILike     FilterOperator = "ilike"
+	OpNotLike   FilterOperator = "not_like"
 )
 
 // SortDirection represents sort direction
@@ -27,33 +36,80 @@ const (
 	SortDesc SortDirection = "desc"
 )
 
-// FilterExpression represents a filter condition
+// NullsOrder controls where NULL values sort relative to other values
+type NullsOrder string
+
+const (
+	// NullsDefault keeps MySQL's default: NULLs first ascending, last descending
+	NullsDefault NullsOrder = ""
+	NullsFirst   NullsOrder = "first"
+	NullsLast    NullsOrder = "last"
+)
+
+// SortKey is one column of a query's ordering
+type SortKey struct {
+	Column    string        `json:"column"`
+	Direction SortDirection `json:"direction"`
+	Nulls     NullsOrder    `json:"nulls,omitempty"`
+}
+
+// LogicalOperator combines a group of filter conditions
+type LogicalOperator string
+
+const (
+	LogicAnd LogicalOperator = "and"
+	LogicOr  LogicalOperator = "or"
+	LogicNot LogicalOperator = "not"
+)
+
+// FilterExpression represents a filter condition.
+// A leaf condition sets Column, Operator and Value; a group sets Logic and Filters.
 type FilterExpression struct {
-	Column   string         `json:"column"`
-	Operator FilterOperator `json:"operator"`
-	Value    interface{}    `json:"value,omitempty"`
+	Column   string             `json:"column,omitempty"`
+	Operator FilterOperator     `json:"operator,omitempty"`
+	Value    interface{}        `json:"value,omitempty"`
+	Logic    LogicalOperator    `json:"logic,omitempty"`
+	Filters  []FilterExpression `json:"filters,omitempty"`
 }
 
 // QueryBuilder provides a fluent interface for building queries
 type QueryBuilder struct {
-	client         *Client
-	tableName      string
-	columns        []string
-	filters        []FilterExpression
-	orderColumn    string
-	orderDirection SortDirection
-	limitValue     *int
-	offsetValue    *int
+	client      *Client
+	tableName   string
+	columns     []string
+	embeds      []embedding
+	joins       []joinClause
+	filters     []FilterExpression
+	order       []SortKey
+	aggregates  []Aggregate
+	groupBy     []string
+	having      []FilterExpression
+	limitValue  *int
+	offsetValue *int
+	pendingID   *pendingID
+	err         error // set while building, returned when the query runs
+	tx          *Tx
+	keyset      bool
+	afterCursor string
+	immutable   bool
+}
+
+// pendingID is an ID lookup whose primary key column is resolved before the query is sent
+type pendingID struct {
+	table *Table
+	id    interface{}
 }
 
 // Select specifies columns to select
 func (qb *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	qb = qb.mutable()
 	qb.columns = columns
 	return qb
 }
 
 // Eq adds an equality filter
 func (qb *QueryBuilder) Eq(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpEq,
@@ -64,6 +120,7 @@ func (qb *QueryBuilder) Eq(column string, value interface{}) *QueryBuilder {
 
 // Neq adds a not-equal filter
 func (qb *QueryBuilder) Neq(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpNeq,
@@ -74,6 +131,7 @@ func (qb *QueryBuilder) Neq(column string, value interface{}) *QueryBuilder {
 
 // Gt adds a greater-than filter
 func (qb *QueryBuilder) Gt(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpGt,
@@ -84,6 +142,7 @@ func (qb *QueryBuilder) Gt(column string, value interface{}) *QueryBuilder {
 
 // Gte adds a greater-than-or-equal filter
 func (qb *QueryBuilder) Gte(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpGte,
@@ -94,6 +153,7 @@ func (qb *QueryBuilder) Gte(column string, value interface{}) *QueryBuilder {
 
 // Lt adds a less-than filter
 func (qb *QueryBuilder) Lt(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpLt,
@@ -104,6 +164,7 @@ func (qb *QueryBuilder) Lt(column string, value interface{}) *QueryBuilder {
 
 // Lte adds a less-than-or-equal filter
 func (qb *QueryBuilder) Lte(column string, value interface{}) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpLte,
@@ -114,6 +175,7 @@ func (qb *QueryBuilder) Lte(column string, value interface{}) *QueryBuilder {
 
 // Like adds a LIKE pattern filter
 func (qb *QueryBuilder) Like(column string, pattern string) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpLike,
@@ -124,6 +186,7 @@ func (qb *QueryBuilder) Like(column string, pattern string) *QueryBuilder {
 
 // IsNull adds an IS NULL filter
 func (qb *QueryBuilder) IsNull(column string) *QueryBuilder {
+	qb = qb.mutable()
 	qb.filters = append(qb.filters, FilterExpression{
 		Column:   column,
 		Operator: OpIsNull,
@@ -131,30 +194,166 @@ func (qb *QueryBuilder) IsNull(column string) *QueryBuilder {
 	return qb
 }
 
-// OrderBy sets the order column and direction
+// NotNull adds an IS NOT NULL filter
+func (qb *QueryBuilder) NotNull(column string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpIsNotNull,
+	})
+	return qb
+}
+
+// ILike adds a case-insensitive LIKE pattern filter
+func (qb *QueryBuilder) ILike(column string, pattern string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpILike,
+		Value:    pattern,
+	})
+	return qb
+}
+
+// NotLike adds a NOT LIKE pattern filter
+func (qb *QueryBuilder) NotLike(column string, pattern string) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpNotLike,
+		Value:    pattern,
+	})
+	return qb
+}
+
+// In adds a set membership filter.
+// Values may be passed individually or as a single slice: In("id", 1, 2, 3) or In("id", ids).
+func (qb *QueryBuilder) In(column string, values ...interface{}) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpIn,
+		Value:    normalizeList(values),
+	})
+	return qb
+}
+
+// NotIn adds a negated set membership filter, accepting values like In
+func (qb *QueryBuilder) NotIn(column string, values ...interface{}) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpNotIn,
+		Value:    normalizeList(values),
+	})
+	return qb
+}
+
+// Between adds an inclusive range filter
+func (qb *QueryBuilder) Between(column string, from, to interface{}) *QueryBuilder {
+	qb = qb.mutable()
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   column,
+		Operator: OpBetween,
+		Value:    []interface{}{from, to},
+	})
+	return qb
+}
+
+// Or adds a group whose conditions are combined with OR.
+//
+//	qb.Eq("status", "active").Or(func(q *QueryBuilder) {
+//		q.Eq("role", "admin").Eq("role", "owner")
+//	})
+func (qb *QueryBuilder) Or(group func(*QueryBuilder)) *QueryBuilder {
+	return qb.addGroup(LogicOr, group)
+}
+
+// And adds a group whose conditions are combined with AND (useful inside Or)
+func (qb *QueryBuilder) And(group func(*QueryBuilder)) *QueryBuilder {
+	return qb.addGroup(LogicAnd, group)
+}
+
+// Not adds a group whose conditions are combined with AND and then negated
+func (qb *QueryBuilder) Not(group func(*QueryBuilder)) *QueryBuilder {
+	return qb.addGroup(LogicNot, group)
+}
+
+// addGroup collects the conditions added by group into a nested filter expression
+func (qb *QueryBuilder) addGroup(logic LogicalOperator, group func(*QueryBuilder)) *QueryBuilder {
+	qb = qb.mutable()
+	sub := &QueryBuilder{
+		client:    qb.client,
+		tableName: qb.tableName,
+		filters:   make([]FilterExpression, 0),
+	}
+	group(sub)
+
+	if len(sub.filters) == 0 {
+		return qb
+	}
+
+	qb.filters = append(qb.filters, FilterExpression{
+		Logic:   logic,
+		Filters: sub.filters,
+	})
+	return qb
+}
+
+// OrderBy adds a sort column. Repeated calls add tie-breakers in order:
+//
+//	qb.OrderBy("created_at", wowmysql.SortDesc).OrderBy("id", wowmysql.SortDesc)
 func (qb *QueryBuilder) OrderBy(column string, direction SortDirection) *QueryBuilder {
-	qb.orderColumn = column
-	qb.orderDirection = direction
+	return qb.OrderByNulls(column, direction, NullsDefault)
+}
+
+// OrderByNulls adds a sort column with explicit NULL placement
+func (qb *QueryBuilder) OrderByNulls(column string, direction SortDirection, nulls NullsOrder) *QueryBuilder {
+	qb = qb.mutable()
+	qb.order = append(qb.order, SortKey{Column: column, Direction: direction, Nulls: nulls})
 	return qb
 }
 
 // Limit sets the limit
 func (qb *QueryBuilder) Limit(limit int) *QueryBuilder {
+	qb = qb.mutable()
 	qb.limitValue = &limit
 	return qb
 }
 
 // Offset sets the offset
 func (qb *QueryBuilder) Offset(offset int) *QueryBuilder {
+	qb = qb.mutable()
 	qb.offsetValue = &offset
 	return qb
 }
 
+// Clone returns an independent copy of the builder. Changes to the copy do not
+// affect the original, so a base query can be cloned and extended in several ways.
+func (qb *QueryBuilder) Clone() *QueryBuilder {
+	return qb.clone()
+}
+
+// Immutable returns a copy of the builder in copy-on-write mode: every fluent method
+// on it, and on builders derived from it, returns a new builder and leaves the
+// receiver unchanged. Immutable builders can be shared between goroutines.
+//
+//	active := client.Table("users").Where().Eq("status", "active").Immutable()
+//	admins := active.Eq("role", "admin") // active is unchanged
+func (qb *QueryBuilder) Immutable() *QueryBuilder {
+	c := qb.clone()
+	c.immutable = true
+	return c
+}
+
 // Execute executes the query and returns results
 func (qb *QueryBuilder) Execute() (*QueryResponse, error) {
-	body := qb.buildQueryBody()
+	return qb.ExecuteContext(context.Background())
+}
 
-	resp, err := qb.client.doRequest("POST", fmt.Sprintf("/api/v1/tables/%s/query", qb.tableName), body)
+// ExecuteContext executes the query using the provided context and returns results
+func (qb *QueryBuilder) ExecuteContext(ctx context.Context) (*QueryResponse, error) {
+	resp, err := qb.execute(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +363,12 @@ func (qb *QueryBuilder) Execute() (*QueryResponse, error) {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	if qb.keyset {
+		if result.NextCursor, err = qb.nextCursor(resp); err != nil {
+			return nil, err
+		}
+	}
+
 	return &result, nil
 }
 
@@ -172,10 +377,19 @@ func (qb *QueryBuilder) Get() (*QueryResponse, error) {
 	return qb.Execute()
 }
 
+// GetContext is an alias for ExecuteContext
+func (qb *QueryBuilder) GetContext(ctx context.Context) (*QueryResponse, error) {
+	return qb.ExecuteContext(ctx)
+}
+
 // First retrieves only the first result
 func (qb *QueryBuilder) First() (map[string]interface{}, error) {
-	qb.Limit(1)
-	result, err := qb.Execute()
+	return qb.FirstContext(context.Background())
+}
+
+// FirstContext retrieves only the first result using the provided context
+func (qb *QueryBuilder) FirstContext(ctx context.Context) (map[string]interface{}, error) {
+	result, err := qb.clone().Limit(1).ExecuteContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +403,22 @@ func (qb *QueryBuilder) First() (map[string]interface{}, error) {
 
 // Update updates records matching the query
 func (qb *QueryBuilder) Update(data map[string]interface{}) (*UpdateResponse, error) {
+	return qb.UpdateContext(context.Background(), data)
+}
+
+// UpdateContext updates records matching the query using the provided context
+func (qb *QueryBuilder) UpdateContext(ctx context.Context, data map[string]interface{}) (*UpdateResponse, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+	qb = qb.clone()
+	if err := qb.resolvePendingID(ctx); err != nil {
+		return nil, err
+	}
+	if err := qb.validateWrite(ctx, data); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{
 		"data": data,
 	}
@@ -197,7 +427,7 @@ func (qb *QueryBuilder) Update(data map[string]interface{}) (*UpdateResponse, er
 		body["filters"] = qb.filters
 	}
 
-	resp, err := qb.client.doRequest("PUT", fmt.Sprintf("/api/v1/tables/%s", qb.tableName), body)
+	resp, err := qb.doWrite(ctx, "PUT", fmt.Sprintf("/api/v1/tables/%s", qb.tableName), body)
 	if err != nil {
 		return nil, err
 	}
@@ -210,15 +440,45 @@ func (qb *QueryBuilder) Update(data map[string]interface{}) (*UpdateResponse, er
 	return &result, nil
 }
 
+// UpdateStruct updates records matching the query with the tagged fields of a struct
+func (qb *QueryBuilder) UpdateStruct(v interface{}) (*UpdateResponse, error) {
+	return qb.UpdateStructContext(context.Background(), v)
+}
+
+// UpdateStructContext updates records matching the query with a struct using the provided context
+func (qb *QueryBuilder) UpdateStructContext(ctx context.Context, v interface{}) (*UpdateResponse, error) {
+	data, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+	return qb.UpdateContext(ctx, data)
+}
+
 // Delete deletes records matching the query
 func (qb *QueryBuilder) Delete() (*DeleteResponse, error) {
+	return qb.DeleteContext(context.Background())
+}
+
+// DeleteContext deletes records matching the query using the provided context
+func (qb *QueryBuilder) DeleteContext(ctx context.Context) (*DeleteResponse, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+	qb = qb.clone()
+	if err := qb.resolvePendingID(ctx); err != nil {
+		return nil, err
+	}
+	if err := qb.validateWrite(ctx, nil); err != nil {
+		return nil, err
+	}
+
 	body := make(map[string]interface{})
 
 	if len(qb.filters) > 0 {
 		body["filters"] = qb.filters
 	}
 
-	resp, err := qb.client.doRequest("DELETE", fmt.Sprintf("/api/v1/tables/%s", qb.tableName), body)
+	resp, err := qb.doWrite(ctx, "DELETE", fmt.Sprintf("/api/v1/tables/%s", qb.tableName), body)
 	if err != nil {
 		return nil, err
 	}
@@ -231,21 +491,164 @@ func (qb *QueryBuilder) Delete() (*DeleteResponse, error) {
 	return &result, nil
 }
 
+// execute sends the query and returns the raw response body
+func (qb *QueryBuilder) execute(ctx context.Context) ([]byte, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+	if qb.tx != nil {
+		return nil, ErrTxQuery
+	}
+
+	qb, err := qb.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := qb.buildQueryBody()
+	if err != nil {
+		return nil, err
+	}
+	return qb.client.doReadRequest(ctx, "POST", fmt.Sprintf("/api/v1/tables/%s/query", qb.tableName), body)
+}
+
+// prepare returns a copy of the builder with its ID lookup and embeds resolved from the
+// table schema and its columns validated. Working on a copy means that executing never
+// modifies a shared builder.
+func (qb *QueryBuilder) prepare(ctx context.Context) (*QueryBuilder, error) {
+	qb = qb.clone()
+	if err := qb.resolvePendingID(ctx); err != nil {
+		return nil, err
+	}
+	if err := qb.resolveEmbeds(ctx); err != nil {
+		return nil, err
+	}
+	if err := qb.validateQuery(ctx); err != nil {
+		return nil, err
+	}
+	return qb, nil
+}
+
+// doWrite sends a write request, or queues it when the builder belongs to a transaction
+func (qb *QueryBuilder) doWrite(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	if qb.tx != nil {
+		return qb.tx.queue(method, path, body)
+	}
+	return qb.client.doRequest(ctx, method, path, body)
+}
+
+// resolvePendingID replaces a pending ID lookup with a filter on the table's primary key
+func (qb *QueryBuilder) resolvePendingID(ctx context.Context) error {
+	if qb.pendingID == nil {
+		return nil
+	}
+
+	columns, err := qb.pendingID.table.PrimaryKeyContext(ctx)
+	if err != nil {
+		return err
+	}
+	if len(columns) != 1 {
+		return compositeKeyError(qb.tableName)
+	}
+
+	qb.filters = append(qb.filters, FilterExpression{
+		Column:   columns[0],
+		Operator: OpEq,
+		Value:    qb.pendingID.id,
+	})
+	qb.pendingID = nil
+	return nil
+}
+
+// compositeKeyError reports a GetByID, UpdateByID or DeleteByID call on a table whose
+// primary key has several columns
+func compositeKeyError(table string) error {
+	return &WowMySQLError{Message: fmt.Sprintf("table %s has a composite primary key; use GetByKey, UpdateByKey or DeleteByKey", table)}
+}
+
+// mutable returns the builder a fluent method should modify: the builder itself,
+// or a copy of it in copy-on-write mode
+func (qb *QueryBuilder) mutable() *QueryBuilder {
+	if qb.immutable {
+		return qb.clone()
+	}
+	return qb
+}
+
+// clone returns a copy of the builder that shares no mutable state with it
+func (qb *QueryBuilder) clone() *QueryBuilder {
+	c := *qb
+	c.columns = append([]string(nil), qb.columns...)
+	c.embeds = append([]embedding(nil), qb.embeds...)
+	c.joins = append([]joinClause(nil), qb.joins...)
+	c.filters = append(make([]FilterExpression, 0, len(qb.filters)), qb.filters...)
+	c.order = append([]SortKey(nil), qb.order...)
+	c.aggregates = append([]Aggregate(nil), qb.aggregates...)
+	c.groupBy = append([]string(nil), qb.groupBy...)
+	c.having = append([]FilterExpression(nil), qb.having...)
+	if qb.limitValue != nil {
+		limit := *qb.limitValue
+		c.limitValue = &limit
+	}
+	if qb.offsetValue != nil {
+		offset := *qb.offsetValue
+		c.offsetValue = &offset
+	}
+	return &c
+}
+
 // buildQueryBody builds the query request body
-func (qb *QueryBuilder) buildQueryBody() map[string]interface{} {
+func (qb *QueryBuilder) buildQueryBody() (map[string]interface{}, error) {
 	body := make(map[string]interface{})
 
 	if len(qb.columns) > 0 {
 		body["columns"] = qb.columns
 	}
 
-	if len(qb.filters) > 0 {
-		body["filters"] = qb.filters
+	if len(qb.embeds) > 0 {
+		body["embed"] = qb.embeds
+	}
+
+	if len(qb.joins) > 0 {
+		joins, err := qb.joinSpecs()
+		if err != nil {
+			return nil, err
+		}
+		body["joins"] = joins
+	}
+
+	filters := qb.filters
+	if qb.keyset {
+		cursorFilter, err := qb.cursorFilter()
+		if err != nil {
+			return nil, err
+		}
+		if cursorFilter != nil {
+			filters = append(append(make([]FilterExpression, 0, len(filters)+1), filters...), *cursorFilter)
+		}
+	}
+
+	if len(filters) > 0 {
+		body["filters"] = filters
+	}
+
+	if len(qb.aggregates) > 0 {
+		body["aggregates"] = qb.aggregates
+	}
+
+	if len(qb.groupBy) > 0 {
+		body["group_by"] = qb.groupBy
 	}
 
-	if qb.orderColumn != "" {
-		body["order_by"] = qb.orderColumn
-		body["order_direction"] = qb.orderDirection
+	if len(qb.having) > 0 {
+		body["having"] = qb.having
+	}
+
+	if len(qb.order) > 0 {
+		// order_by/order_direction mirror the first key for servers without multi-column support
+		body["order"] = qb.order
+		body["order_by"] = qb.order[0].Column
+		body["order_direction"] = qb.order[0].Direction
 	}
 
 	if qb.limitValue != nil {
@@ -256,6 +659,26 @@ func (qb *QueryBuilder) buildQueryBody() map[string]interface{} {
 		body["offset"] = *qb.offsetValue
 	}
 
-	return body
+	return body, nil
 }
 
+// normalizeList flattens a single slice argument so that In("id", ids) and
+// In("id", 1, 2, 3) encode the same JSON array
+func normalizeList(values []interface{}) []interface{} {
+	if len(values) == 1 {
+		v := reflect.ValueOf(values[0])
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			if _, isBytes := values[0].([]byte); !isBytes {
+				list := make([]interface{}, v.Len())
+				for i := range list {
+					list[i] = v.Index(i).Interface()
+				}
+				return list
+			}
+		}
+	}
+	if values == nil {
+		return make([]interface{}, 0)
+	}
+	return values
+}
@@ -0,0 +1,147 @@
+package wowmysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// newQueryTestClient returns a client backed by a server that answers every query
+// with one row, or with a count of 3 for aggregate queries
+func newQueryTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, ok := body["aggregates"]; ok {
+			fmt.Fprint(w, `{"data":[{"count":3}],"count":1}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":1,"status":"active"}],"count":1}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewClient(srv.URL, "test-key")
+}
+
+func TestImmutableSharedAcrossGoroutines(t *testing.T) {
+	client := newQueryTestClient(t)
+	ctx := context.Background()
+
+	base := client.Table("users").Where().
+		Eq("status", "active").
+		OrderBy("id", SortAsc).
+		Limit(10).
+		Immutable()
+
+	wantFilters := append([]FilterExpression(nil), base.filters...)
+	wantOrder := append([]SortKey(nil), base.order...)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			derived := base.Eq("role", i).Gt("age", i).OrderBy("name", SortDesc).Limit(i + 1)
+			if len(derived.filters) != 3 || len(derived.order) != 2 || *derived.limitValue != i+1 {
+				t.Errorf("derived query %d has filters %v, order %v, limit %d", i, derived.filters, derived.order, *derived.limitValue)
+			}
+
+			if _, err := derived.Execute(); err != nil {
+				t.Errorf("Execute: %v", err)
+			}
+			if count, err := derived.Count(ctx); err != nil || count != 3 {
+				t.Errorf("Count = %d, %v; want 3, nil", count, err)
+			}
+			if row, err := derived.First(); err != nil || row == nil {
+				t.Errorf("First = %v, %v; want a row", row, err)
+			}
+
+			if _, err := base.Execute(); err != nil {
+				t.Errorf("Execute on base: %v", err)
+			}
+			if _, err := base.Count(ctx); err != nil {
+				t.Errorf("Count on base: %v", err)
+			}
+			if _, err := base.First(); err != nil {
+				t.Errorf("First on base: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if !reflect.DeepEqual(base.filters, wantFilters) {
+		t.Errorf("base filters = %v, want %v", base.filters, wantFilters)
+	}
+	if !reflect.DeepEqual(base.order, wantOrder) {
+		t.Errorf("base order = %v, want %v", base.order, wantOrder)
+	}
+	if base.limitValue == nil || *base.limitValue != 10 {
+		t.Errorf("base limit = %v, want 10", base.limitValue)
+	}
+	if base.offsetValue != nil || len(base.aggregates) != 0 {
+		t.Errorf("base gained offset %v or aggregates %v", base.offsetValue, base.aggregates)
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	client := newQueryTestClient(t)
+
+	original := client.Table("users").Where().Eq("status", "active").OrderBy("id", SortAsc).Limit(5)
+	clone := original.Clone()
+
+	// Builders that are not immutable change in place
+	clone.Eq("role", "admin").OrderBy("name", SortDesc).Limit(1).Offset(3)
+	original.Eq("deleted", false)
+
+	if len(original.filters) != 2 || original.filters[1].Column != "deleted" {
+		t.Errorf("original filters = %v, want status and deleted", original.filters)
+	}
+	if len(original.order) != 1 || *original.limitValue != 5 || original.offsetValue != nil {
+		t.Errorf("original order %v, limit %d, offset %v changed with the clone", original.order, *original.limitValue, original.offsetValue)
+	}
+
+	if len(clone.filters) != 2 || clone.filters[1].Column != "role" {
+		t.Errorf("clone filters = %v, want status and role", clone.filters)
+	}
+	if len(clone.order) != 2 || *clone.limitValue != 1 || clone.offsetValue == nil || *clone.offsetValue != 3 {
+		t.Errorf("clone order %v, limit %d, offset %v, want two keys, 1 and 3", clone.order, *clone.limitValue, clone.offsetValue)
+	}
+}
+
+func TestFirstDoesNotMutateReceiver(t *testing.T) {
+	client := newQueryTestClient(t)
+
+	qb := client.Table("users").Select("*").Eq("status", "active").Limit(10)
+	for i := 0; i < 2; i++ {
+		row, err := qb.First()
+		if err != nil || row == nil {
+			t.Fatalf("First = %v, %v; want a row", row, err)
+		}
+	}
+	if *qb.limitValue != 10 || len(qb.filters) != 1 {
+		t.Errorf("after First: limit %d, filters %v; want 10 and one filter", *qb.limitValue, qb.filters)
+	}
+
+	byID := client.Table("users").WithPrimaryKey("id").GetByID(1)
+	for i := 0; i < 2; i++ {
+		if _, err := byID.First(); err != nil {
+			t.Fatalf("First by ID: %v", err)
+		}
+	}
+	if byID.pendingID == nil || len(byID.filters) != 0 {
+		t.Errorf("after First: pending ID %v, filters %v; want the lookup unresolved", byID.pendingID, byID.filters)
+	}
+}
@@ -0,0 +1,325 @@
+package wowmysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ToJSON returns the request body the query sends to the server.
+// It works offline: a GetByID lookup uses the table's known primary key (or "id") and
+// fails for a composite key, and Embed relationships appear without the join columns
+// resolved from the schema. Use ToJSONContext for the exact body Execute sends.
+func (qb *QueryBuilder) ToJSON() ([]byte, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+	q, err := qb.offline()
+	if err != nil {
+		return nil, err
+	}
+	body, err := q.buildQueryBody()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(body)
+}
+
+// ToJSONContext returns the request body the query sends to the server, resolving a
+// GetByID lookup and Embed join columns from the table schema as Execute does
+func (qb *QueryBuilder) ToJSONContext(ctx context.Context) ([]byte, error) {
+	if qb.err != nil {
+		return nil, qb.err
+	}
+	q, err := qb.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := q.buildQueryBody()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(body)
+}
+
+// ToSQL returns a parameterized MySQL statement equivalent to the query, with its
+// arguments in placeholder order. The server builds its own SQL, so this is meant
+// for debugging and logging; embedded relations are noted in a comment.
+func (qb *QueryBuilder) ToSQL() (string, []interface{}, error) {
+	if qb.err != nil {
+		return "", nil, qb.err
+	}
+	q, err := qb.offline()
+	if err != nil {
+		return "", nil, err
+	}
+
+	filters := q.filters
+	if q.keyset {
+		cursorFilter, err := q.cursorFilter()
+		if err != nil {
+			return "", nil, err
+		}
+		if cursorFilter != nil {
+			filters = append(append(make([]FilterExpression, 0, len(filters)+1), filters...), *cursorFilter)
+		}
+	}
+
+	r := &sqlRenderer{}
+	r.sql.WriteString("SELECT ")
+	r.selectList(q)
+	r.sql.WriteString(" FROM ")
+	r.sql.WriteString(quoteIdentifier(q.tableName))
+
+	joins, err := q.joinSpecs()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, join := range joins {
+		fmt.Fprintf(&r.sql, " %s JOIN %s ON %s = %s",
+			strings.ToUpper(string(join.Kind)), quoteIdentifier(join.Table), quoteIdentifier(join.Left), quoteIdentifier(join.Right))
+	}
+
+	if len(filters) > 0 {
+		r.sql.WriteString(" WHERE ")
+		if err := r.conditions(filters, " AND "); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(q.groupBy) > 0 {
+		r.sql.WriteString(" GROUP BY ")
+		r.identifiers(q.groupBy)
+	}
+
+	if len(q.having) > 0 {
+		r.sql.WriteString(" HAVING ")
+		if err := r.conditions(q.having, " AND "); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(q.order) > 0 {
+		r.sql.WriteString(" ORDER BY ")
+		r.orderBy(q.order)
+	}
+
+	switch {
+	case q.limitValue != nil:
+		fmt.Fprintf(&r.sql, " LIMIT %d", *q.limitValue)
+	case q.offsetValue != nil:
+		// MySQL requires a LIMIT with OFFSET; this is the documented "no limit" value
+		r.sql.WriteString(" LIMIT 18446744073709551615")
+	}
+	if q.offsetValue != nil {
+		fmt.Fprintf(&r.sql, " OFFSET %d", *q.offsetValue)
+	}
+
+	for _, embed := range q.embeds {
+		fmt.Fprintf(&r.sql, " /* embed %s", embed.Relation)
+		if len(embed.Columns) > 0 {
+			fmt.Fprintf(&r.sql, "(%s)", strings.Join(embed.Columns, ", "))
+		}
+		r.sql.WriteString(" */")
+	}
+
+	return r.sql.String(), r.args, nil
+}
+
+// String renders the query as SQL followed by its arguments, for logs and test failures
+func (qb *QueryBuilder) String() string {
+	sql, args, err := qb.ToSQL()
+	if err != nil {
+		return fmt.Sprintf("QueryBuilder(%s: %v)", qb.tableName, err)
+	}
+	if len(args) == 0 {
+		return sql
+	}
+	return fmt.Sprintf("%s %v", sql, args)
+}
+
+// offline returns a copy of the builder with any pending ID lookup turned into a filter
+// without contacting the server
+func (qb *QueryBuilder) offline() (*QueryBuilder, error) {
+	q := qb.clone()
+	if q.pendingID != nil {
+		columns := q.pendingID.table.knownPrimaryKey()
+		if len(columns) != 1 {
+			return nil, compositeKeyError(q.tableName)
+		}
+		q.filters = append(q.filters, FilterExpression{
+			Column:   columns[0],
+			Operator: OpEq,
+			Value:    q.pendingID.id,
+		})
+		q.pendingID = nil
+	}
+	return q, nil
+}
+
+// sqlRenderer accumulates a parameterized SQL statement
+type sqlRenderer struct {
+	sql  strings.Builder
+	args []interface{}
+}
+
+// selectList writes the selected columns and aggregates
+func (r *sqlRenderer) selectList(q *QueryBuilder) {
+	items := make([]string, 0, len(q.columns)+len(q.aggregates))
+	columns := q.columns
+	if len(columns) == 0 && len(q.aggregates) > 0 {
+		columns = q.groupBy
+	}
+	for _, column := range columns {
+		items = append(items, quoteIdentifier(column))
+	}
+	for _, aggregate := range q.aggregates {
+		column := "*"
+		if aggregate.Column != "*" && aggregate.Column != "" {
+			column = quoteIdentifier(aggregate.Column)
+		}
+		items = append(items, fmt.Sprintf("%s(%s) AS %s", strings.ToUpper(string(aggregate.Function)), column, quoteIdentifier(aggregate.Alias)))
+	}
+
+	if len(items) == 0 {
+		r.sql.WriteString("*")
+		return
+	}
+	r.sql.WriteString(strings.Join(items, ", "))
+}
+
+// identifiers writes a comma-separated list of quoted identifiers
+func (r *sqlRenderer) identifiers(names []string) {
+	for i, name := range names {
+		if i > 0 {
+			r.sql.WriteString(", ")
+		}
+		r.sql.WriteString(quoteIdentifier(name))
+	}
+}
+
+// orderBy writes the sort keys; MySQL has no NULLS FIRST/LAST, so they become IS NULL terms
+func (r *sqlRenderer) orderBy(keys []SortKey) {
+	for i, key := range keys {
+		if i > 0 {
+			r.sql.WriteString(", ")
+		}
+		column := quoteIdentifier(key.Column)
+		switch key.Nulls {
+		case NullsFirst:
+			fmt.Fprintf(&r.sql, "%s IS NULL DESC, ", column)
+		case NullsLast:
+			fmt.Fprintf(&r.sql, "%s IS NULL ASC, ", column)
+		}
+		direction := "ASC"
+		if key.Direction == SortDesc {
+			direction = "DESC"
+		}
+		fmt.Fprintf(&r.sql, "%s %s", column, direction)
+	}
+}
+
+// conditions writes filters joined by separator
+func (r *sqlRenderer) conditions(filters []FilterExpression, separator string) error {
+	for i, filter := range filters {
+		if i > 0 {
+			r.sql.WriteString(separator)
+		}
+		if err := r.condition(filter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// condition writes a single filter or filter group
+func (r *sqlRenderer) condition(filter FilterExpression) error {
+	if filter.Logic != "" {
+		switch filter.Logic {
+		case LogicAnd, LogicOr:
+			r.sql.WriteString("(")
+			if err := r.conditions(filter.Filters, " "+strings.ToUpper(string(filter.Logic))+" "); err != nil {
+				return err
+			}
+			r.sql.WriteString(")")
+		case LogicNot:
+			r.sql.WriteString("NOT (")
+			if err := r.conditions(filter.Filters, " AND "); err != nil {
+				return err
+			}
+			r.sql.WriteString(")")
+		default:
+			return fmt.Errorf("unsupported filter logic %q", filter.Logic)
+		}
+		return nil
+	}
+
+	column := quoteIdentifier(filter.Column)
+	switch filter.Operator {
+	case OpEq, OpNeq, OpGt, OpGte, OpLt, OpLte, OpLike, OpNotLike:
+		fmt.Fprintf(&r.sql, "%s %s ?", column, comparisonOperators[filter.Operator])
+		r.args = append(r.args, filter.Value)
+	case OpILike:
+		fmt.Fprintf(&r.sql, "LOWER(%s) LIKE LOWER(?)", column)
+		r.args = append(r.args, filter.Value)
+	case OpIsNull:
+		fmt.Fprintf(&r.sql, "%s IS NULL", column)
+	case OpIsNotNull:
+		fmt.Fprintf(&r.sql, "%s IS NOT NULL", column)
+	case OpIn, OpNotIn:
+		values, _ := filter.Value.([]interface{})
+		if len(values) == 0 {
+			// An empty IN list matches nothing and an empty NOT IN list matches everything
+			if filter.Operator == OpIn {
+				r.sql.WriteString("1 = 0")
+			} else {
+				r.sql.WriteString("1 = 1")
+			}
+			return nil
+		}
+		keyword := "IN"
+		if filter.Operator == OpNotIn {
+			keyword = "NOT IN"
+		}
+		fmt.Fprintf(&r.sql, "%s %s (%s)", column, keyword, strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "))
+		r.args = append(r.args, values...)
+	case OpBetween:
+		bounds, _ := filter.Value.([]interface{})
+		if len(bounds) != 2 {
+			return fmt.Errorf("between filter on %s needs two bounds", filter.Column)
+		}
+		fmt.Fprintf(&r.sql, "%s BETWEEN ? AND ?", column)
+		r.args = append(r.args, bounds...)
+	default:
+		return fmt.Errorf("unsupported filter operator %q", filter.Operator)
+	}
+	return nil
+}
+
+// comparisonOperators maps binary filter operators to SQL
+var comparisonOperators = map[FilterOperator]string{
+	OpEq:      "=",
+	OpNeq:     "<>",
+	OpGt:      ">",
+	OpGte:     ">=",
+	OpLt:      "<",
+	OpLte:     "<=",
+	OpLike:    "LIKE",
+	OpNotLike: "NOT LIKE",
+}
+
+// quoteIdentifier backtick-quotes a column or table name, quoting each part of a
+// qualified name separately. "*" and expressions containing parentheses are left as-is.
+func quoteIdentifier(name string) string {
+	if name == "*" || strings.ContainsAny(name, "()") {
+		return name
+	}
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if part != "*" {
+			parts[i] = "`" + strings.ReplaceAll(part, "`", "``") + "`"
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,164 @@
+package wowmysql
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Requests are retried on network errors and on the configured status codes.
+// Non-idempotent requests (such as Insert) are only retried when RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the computed backoff delay
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each attempt
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0 to 1)
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that are retried
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows retrying requests that are not idempotent
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a retry policy with 3 attempts and exponential backoff
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       200 * time.Millisecond,
+		MaxBackoff:           5 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{429, 502, 503, 504},
+	}
+}
+
+// WithRetryPolicy enables automatic retries using the given policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = &policy
+	}
+}
+
+// retryPolicyOrNil returns the configured retry policy, or nil if retries are disabled
+func (o *clientOptions) retryPolicyOrNil() *RetryPolicy {
+	if o == nil {
+		return nil
+	}
+	return o.retryPolicy
+}
+
+// send performs the request, retrying according to the policy.
+// A nil policy sends the request exactly once.
+func (p *RetryPolicy) send(ctx context.Context, httpClient *http.Client, req *http.Request, idempotent bool) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		resp, err := httpClient.Do(r)
+		if !p.shouldRetry(attempt, idempotent, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if retryAfter := parseRetryAfter(resp.Header); retryAfter > 0 {
+				delay = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether another attempt should be made
+func (p *RetryPolicy) shouldRetry(attempt int, idempotent bool, resp *http.Response, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if !idempotent && !p.RetryNonIdempotent {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the next attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		delay -= delay * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// isIdempotentMethod reports whether the HTTP method is idempotent
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
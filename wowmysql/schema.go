@@ -0,0 +1,172 @@
+package wowmysql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Column returns the column with the given name
+func (s *TableSchema) Column(name string) (ColumnInfo, bool) {
+	for _, column := range s.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+	return ColumnInfo{}, false
+}
+
+// PrimaryKeyColumns returns the primary key columns in key order, including every
+// column of a composite key, or nil when the table has no primary key
+func (s *TableSchema) PrimaryKeyColumns() []string {
+	for _, index := range s.Indexes {
+		if index.Primary && len(index.Columns) > 0 {
+			return append([]string(nil), index.Columns...)
+		}
+	}
+	if s.PrimaryKey != nil && *s.PrimaryKey != "" {
+		return []string{*s.PrimaryKey}
+	}
+	return nil
+}
+
+// UniqueIndexes returns the unique indexes, including the primary key
+func (s *TableSchema) UniqueIndexes() []IndexInfo {
+	var indexes []IndexInfo
+	for _, index := range s.Indexes {
+		if index.Unique || index.Primary {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// clone returns a deep copy of the schema, so that callers cannot modify the cached one
+func (s *TableSchema) clone() *TableSchema {
+	c := *s
+	c.PrimaryKey = clonePtr(s.PrimaryKey)
+	c.RowCount = clonePtr(s.RowCount)
+
+	c.Columns = append([]ColumnInfo(nil), s.Columns...)
+	for i := range c.Columns {
+		column := &c.Columns[i]
+		column.MaxLength = clonePtr(column.MaxLength)
+		column.NumericPrecision = clonePtr(column.NumericPrecision)
+		column.NumericScale = clonePtr(column.NumericScale)
+		column.EnumValues = append([]string(nil), column.EnumValues...)
+	}
+
+	c.Indexes = append([]IndexInfo(nil), s.Indexes...)
+	for i := range c.Indexes {
+		c.Indexes[i].Columns = append([]string(nil), c.Indexes[i].Columns...)
+	}
+	c.ForeignKeys = append([]ForeignKey(nil), s.ForeignKeys...)
+	return &c
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// fillFromTypes derives column metadata the server did not send from the column types
+func (s *TableSchema) fillFromTypes() {
+	for i := range s.Columns {
+		s.Columns[i].fillFromType()
+	}
+}
+
+// fillFromType parses a MySQL column type such as "decimal(10,2) unsigned",
+// "varchar(255)" or "enum('a','b')" into the column's metadata fields
+func (c *ColumnInfo) fillFromType() {
+	base := columnBaseType(c.Type)
+	args := typeArguments(c.Type)
+
+	for _, modifier := range strings.Fields(typeModifiers(c.Type)) {
+		if strings.EqualFold(modifier, "unsigned") {
+			c.Unsigned = true
+		}
+	}
+
+	switch base {
+	case "ENUM", "SET":
+		if c.EnumValues == nil {
+			c.EnumValues = parseEnumValues(args)
+		}
+	case "CHAR", "VARCHAR", "BINARY", "VARBINARY":
+		if c.MaxLength == nil {
+			if n, err := strconv.ParseInt(strings.TrimSpace(args), 10, 64); err == nil {
+				c.MaxLength = &n
+			}
+		}
+	case "DECIMAL", "NUMERIC":
+		precision, scale, _ := strings.Cut(args, ",")
+		if c.NumericPrecision == nil {
+			if p, err := strconv.Atoi(strings.TrimSpace(precision)); err == nil {
+				c.NumericPrecision = &p
+			}
+		}
+		if c.NumericScale == nil && c.NumericPrecision != nil {
+			sc := 0
+			if scale != "" {
+				sc, _ = strconv.Atoi(strings.TrimSpace(scale))
+			}
+			c.NumericScale = &sc
+		}
+	}
+}
+
+// typeArguments returns the text between the parentheses of a column type
+func typeArguments(typ string) string {
+	open := strings.IndexByte(typ, '(')
+	end := strings.LastIndexByte(typ, ')')
+	if open < 0 || end < open {
+		return ""
+	}
+	return typ[open+1 : end]
+}
+
+// typeModifiers returns the modifiers that follow a column type's base name and
+// arguments, e.g. "unsigned zerofill" for "int(10) unsigned zerofill". Quoted ENUM
+// and SET values are never part of the result.
+func typeModifiers(typ string) string {
+	if end := strings.LastIndexByte(typ, ')'); end >= 0 && strings.IndexByte(typ, '(') >= 0 {
+		return typ[end+1:]
+	}
+	if i := strings.IndexByte(strings.TrimSpace(typ), ' '); i >= 0 {
+		return strings.TrimSpace(typ)[i+1:]
+	}
+	return ""
+}
+
+// parseEnumValues parses a quoted value list such as 'a','b' into its values,
+// unescaping doubled quotes and backslash escapes
+func parseEnumValues(list string) []string {
+	var (
+		values  []string
+		current strings.Builder
+		quoted  bool
+	)
+	for i := 0; i < len(list); i++ {
+		c := list[i]
+		switch {
+		case !quoted && c == '\'':
+			quoted = true
+			current.Reset()
+		case quoted && c == '\\' && i+1 < len(list):
+			i++
+			current.WriteByte(list[i])
+		case quoted && c == '\'' && i+1 < len(list) && list[i+1] == '\'':
+			i++
+			current.WriteByte('\'')
+		case quoted && c == '\'':
+			quoted = false
+			values = append(values, current.String())
+		case quoted:
+			current.WriteByte(c)
+		}
+	}
+	return values
+}
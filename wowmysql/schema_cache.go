@@ -0,0 +1,143 @@
+package wowmysql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultSchemaCacheTTL is how long table schemas and the table list are cached by default
+const defaultSchemaCacheTTL = 5 * time.Minute
+
+// tablesCacheKey is the cache key of the table list; schema keys are table names
+const tablesCacheKey = "\x00tables"
+
+// primaryKeyFallbackKey is the cache key recording that a table's schema could not be
+// read and its primary key fell back to "id"
+func primaryKeyFallbackKey(tableName string) string {
+	return "\x00pk-fallback:" + tableName
+}
+
+// schemaCache caches schema lookups for a TTL and deduplicates concurrent fetches,
+// so that many goroutines asking for the same schema cause a single request
+type schemaCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]schemaEntry
+	calls   map[string]*schemaCall
+}
+
+// schemaEntry is a cached value and its expiry time
+type schemaEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// schemaCall is a fetch in flight; done is closed once value and err are set
+type schemaCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newSchemaCache(ttl time.Duration) *schemaCache {
+	return &schemaCache{
+		ttl:     ttl,
+		entries: make(map[string]schemaEntry),
+		calls:   make(map[string]*schemaCall),
+	}
+}
+
+// get returns the cached value for key, or fetches it. Concurrent callers share one fetch,
+// which is detached from the first caller's cancellation so that it can still serve the others.
+func (s *schemaCache) get(ctx context.Context, key string, fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	s.mu.Lock()
+	if entry, ok := s.entries[key]; ok && time.Now().Before(entry.expires) {
+		s.mu.Unlock()
+		return entry.value, nil
+	}
+	call, ok := s.calls[key]
+	if !ok {
+		call = &schemaCall{done: make(chan struct{})}
+		s.calls[key] = call
+		go s.fill(context.WithoutCancel(ctx), key, call, fetch)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fill runs a fetch and stores its result unless the key was invalidated meanwhile
+func (s *schemaCache) fill(ctx context.Context, key string, call *schemaCall, fetch func(context.Context) (interface{}, error)) {
+	call.value, call.err = fetch(ctx)
+
+	s.mu.Lock()
+	if s.calls[key] == call {
+		delete(s.calls, key)
+		if call.err == nil && s.ttl > 0 {
+			s.entries[key] = schemaEntry{value: call.value, expires: time.Now().Add(s.ttl)}
+		}
+	}
+	s.mu.Unlock()
+
+	close(call.done)
+}
+
+// put stores a value for the cache TTL
+func (s *schemaCache) put(key string, value interface{}) {
+	if s.ttl <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = schemaEntry{value: value, expires: time.Now().Add(s.ttl)}
+}
+
+// peek returns a cached value without fetching it
+func (s *schemaCache) peek(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok || !time.Now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// invalidate drops a cached value; a fetch already in flight is not stored
+func (s *schemaCache) invalidate(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	delete(s.calls, key)
+}
+
+// clear drops every cached value
+func (s *schemaCache) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[string]schemaEntry)
+	s.calls = make(map[string]*schemaCall)
+}
+
+// InvalidateSchema drops the cached schema of a table, so the next lookup fetches it again.
+// Call it after altering the table.
+func (c *Client) InvalidateSchema(tableName string) {
+	c.schemas.invalidate(tableName)
+	c.schemas.invalidate(primaryKeyFallbackKey(tableName))
+}
+
+// InvalidateSchemaCache drops every cached schema and the cached table list
+func (c *Client) InvalidateSchemaCache() {
+	c.schemas.clear()
+}
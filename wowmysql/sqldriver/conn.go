@@ -0,0 +1,151 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	"github.com/wowmysql/wowmysql-go/wowmysql"
+)
+
+// errTxUnsupported is returned by Begin; the HTTP API has no interactive transactions
+var errTxUnsupported = errors.New("wowmysql: transactions are not supported by the database/sql driver; use Client.Transaction")
+
+// conn implements driver.Conn on top of a stateless HTTP client
+type conn struct {
+	client    *wowmysql.Client
+	parseTime bool
+}
+
+var (
+	_ driver.Conn               = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, errTxUnsupported
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return nil, errTxUnsupported
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	_, err := c.client.HealthContext(ctx)
+	return err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	params, err := positionalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.client.QueryResultSet(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRows(result, c.parseTime), nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	params, err := positionalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.client.Exec(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return execResult{result}, nil
+}
+
+// positionalArgs converts ordinal driver arguments into Client parameters
+func positionalArgs(args []driver.NamedValue) ([]interface{}, error) {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("wowmysql: named arguments are not supported; use ? placeholders")
+		}
+		params[i] = arg.Value
+	}
+	return params, nil
+}
+
+// stmt implements driver.Stmt; statements are not prepared server-side
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+var (
+	_ driver.Stmt             = (*stmt)(nil)
+	_ driver.StmtQueryContext = (*stmt)(nil)
+	_ driver.StmtExecContext  = (*stmt)(nil)
+)
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+// NumInput returns -1 because placeholders are counted by the server
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+// namedValues converts legacy driver values into ordinal named values
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+// execResult implements driver.Result
+type execResult struct {
+	result *wowmysql.ExecResult
+}
+
+func (r execResult) LastInsertId() (int64, error) {
+	return r.result.LastInsertID, nil
+}
+
+func (r execResult) RowsAffected() (int64, error) {
+	return r.result.RowsAffected, nil
+}
@@ -0,0 +1,152 @@
+// Package sqldriver provides a database/sql driver backed by the WowMySQL HTTP API.
+//
+// Importing the package registers a driver named "wowmysql":
+//
+//	import _ "github.com/wowmysql/wowmysql-go/wowmysql/sqldriver"
+//
+//	db, err := sql.Open("wowmysql", "wowmysql://your-api-key@your-project.wowmysql.com?parseTime=true")
+//
+// Queries map onto Client.QueryResultSet and statements onto Client.Exec.
+// Supported DSN parameters:
+//
+//	tls=false         use http instead of https
+//	timeout=30s       HTTP client timeout
+//	allowWrites=true  allow Exec (see wowmysql.WithWriteAccess)
+//	parseTime=true    scan DATE, DATETIME and TIMESTAMP columns into time.Time
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wowmysql/wowmysql-go/wowmysql"
+)
+
+// DriverName is the name the driver is registered under
+const DriverName = "wowmysql"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Driver implements driver.Driver and driver.DriverContext
+type Driver struct{}
+
+// Open opens a new connection using a DSN
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	connector, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(context.Background())
+}
+
+// OpenConnector parses the DSN once and returns a connector for it
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	config, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []wowmysql.Option{wowmysql.WithTimeout(config.Timeout)}
+	if config.AllowWrites {
+		opts = append(opts, wowmysql.WithWriteAccess())
+	}
+
+	return &connector{
+		driver:    d,
+		client:    wowmysql.NewClient(config.ProjectURL, config.APIKey, opts...),
+		parseTime: config.ParseTime,
+	}, nil
+}
+
+// NewConnector returns a connector that uses an existing client, for use with sql.OpenDB
+func NewConnector(client *wowmysql.Client, parseTime bool) driver.Connector {
+	return &connector{
+		driver:    &Driver{},
+		client:    client,
+		parseTime: parseTime,
+	}
+}
+
+// Config holds the settings parsed from a DSN
+type Config struct {
+	ProjectURL  string
+	APIKey      string
+	Timeout     time.Duration
+	AllowWrites bool
+	ParseTime   bool
+}
+
+// ParseDSN parses a DSN of the form wowmysql://apikey@project.wowmysql.com?param=value
+func ParseDSN(dsn string) (*Config, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("wowmysql: invalid DSN: %w", err)
+	}
+	if u.Scheme != DriverName {
+		return nil, fmt.Errorf("wowmysql: invalid DSN: scheme must be %q, got %q", DriverName, u.Scheme)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("wowmysql: invalid DSN: missing API key")
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("wowmysql: invalid DSN: missing host")
+	}
+
+	config := &Config{
+		ProjectURL: "https://" + u.Host,
+		APIKey:     u.User.Username(),
+		Timeout:    30 * time.Second,
+	}
+
+	query := u.Query()
+	if value := query.Get("tls"); value != "" {
+		secure, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("wowmysql: invalid DSN: tls: %w", err)
+		}
+		if !secure {
+			config.ProjectURL = "http://" + u.Host
+		}
+	}
+	if value := query.Get("timeout"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("wowmysql: invalid DSN: timeout: %w", err)
+		}
+		config.Timeout = timeout
+	}
+	if value := query.Get("allowWrites"); value != "" {
+		if config.AllowWrites, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("wowmysql: invalid DSN: allowWrites: %w", err)
+		}
+	}
+	if value := query.Get("parseTime"); value != "" {
+		if config.ParseTime, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("wowmysql: invalid DSN: parseTime: %w", err)
+		}
+	}
+
+	return config, nil
+}
+
+// connector implements driver.Connector
+type connector struct {
+	driver    *Driver
+	client    *wowmysql.Client
+	parseTime bool
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &conn{client: c.client, parseTime: c.parseTime}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
@@ -0,0 +1,58 @@
+package sqldriver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		dsn     string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			dsn:  "wowmysql://key@demo.wowmysql.com",
+			want: &Config{ProjectURL: "https://demo.wowmysql.com", APIKey: "key", Timeout: 30 * time.Second},
+		},
+		{
+			name: "all parameters",
+			dsn:  "wowmysql://key@localhost:8080?tls=false&timeout=5s&allowWrites=true&parseTime=true",
+			want: &Config{
+				ProjectURL:  "http://localhost:8080",
+				APIKey:      "key",
+				Timeout:     5 * time.Second,
+				AllowWrites: true,
+				ParseTime:   true,
+			},
+		},
+		{name: "wrong scheme", dsn: "mysql://key@demo.wowmysql.com", wantErr: true},
+		{name: "missing API key", dsn: "wowmysql://demo.wowmysql.com", wantErr: true},
+		{name: "missing host", dsn: "wowmysql://key@", wantErr: true},
+		{name: "invalid tls", dsn: "wowmysql://key@demo.wowmysql.com?tls=maybe", wantErr: true},
+		{name: "invalid timeout", dsn: "wowmysql://key@demo.wowmysql.com?timeout=soon", wantErr: true},
+		{name: "invalid allowWrites", dsn: "wowmysql://key@demo.wowmysql.com?allowWrites=yes", wantErr: true},
+		{name: "invalid parseTime", dsn: "wowmysql://key@demo.wowmysql.com?parseTime=2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDSN(tt.dsn)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDSN(%q) = %+v, want an error", tt.dsn, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDSN(%q): %v", tt.dsn, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDSN(%q) = %+v, want %+v", tt.dsn, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package sqldriver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/wowmysql/wowmysql-go/wowmysql"
+)
+
+// timeLayouts are the formats tried when parseTime is enabled
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+var (
+	scanTypeInt64       = reflect.TypeOf(int64(0))
+	scanTypeNullInt64   = reflect.TypeOf(sql.NullInt64{})
+	scanTypeUint64      = reflect.TypeOf(uint64(0))
+	scanTypeNullUint64  = reflect.TypeOf(sql.Null[uint64]{})
+	scanTypeFloat64     = reflect.TypeOf(float64(0))
+	scanTypeNullFloat64 = reflect.TypeOf(sql.NullFloat64{})
+	scanTypeString      = reflect.TypeOf("")
+	scanTypeNullString  = reflect.TypeOf(sql.NullString{})
+	scanTypeTime        = reflect.TypeOf(time.Time{})
+	scanTypeNullTime    = reflect.TypeOf(sql.NullTime{})
+	scanTypeBytes       = reflect.TypeOf([]byte(nil))
+	scanTypeUnknown     = reflect.TypeOf(new(interface{})).Elem()
+)
+
+// column is a result column with its MySQL base type
+type column struct {
+	info     wowmysql.ColumnInfo
+	baseType string // upper-case type name without length or modifiers, e.g. "VARCHAR"
+}
+
+// parseColumn extracts the base type from a MySQL column type such as "decimal(10,2) unsigned";
+// length, precision and signedness come from the column metadata
+func parseColumn(info wowmysql.ColumnInfo) column {
+	typ := strings.TrimSpace(info.Type)
+	if i := strings.IndexAny(typ, "( "); i >= 0 {
+		typ = typ[:i]
+	}
+	return column{info: info, baseType: strings.ToUpper(typ)}
+}
+
+// rows implements driver.Rows over a fully fetched result set
+type rows struct {
+	columns   []column
+	data      [][]interface{}
+	pos       int
+	parseTime bool
+}
+
+var (
+	_ driver.Rows                           = (*rows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*rows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*rows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*rows)(nil)
+	_ driver.RowsColumnTypeLength           = (*rows)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*rows)(nil)
+)
+
+func newRows(result *wowmysql.ResultSet, parseTime bool) *rows {
+	columns := make([]column, len(result.Columns))
+	for i, info := range result.Columns {
+		columns[i] = parseColumn(info)
+	}
+	return &rows{columns: columns, data: result.Rows, parseTime: parseTime}
+}
+
+func (r *rows) Columns() []string {
+	names := make([]string, len(r.columns))
+	for i, c := range r.columns {
+		names[i] = c.info.Name
+	}
+	return names
+}
+
+func (r *rows) Close() error {
+	r.data = nil
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.data) {
+		return io.EOF
+	}
+
+	row := r.data[r.pos]
+	r.pos++
+	for i := range dest {
+		value, err := r.convert(r.columns[i], row[i])
+		if err != nil {
+			return err
+		}
+		dest[i] = value
+	}
+	return nil
+}
+
+// convert turns a decoded JSON value into a driver.Value for the column
+func (r *rows) convert(c column, value interface{}) (driver.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		return v, nil
+	case json.Number:
+		if c.baseType == "DECIMAL" || c.baseType == "NUMERIC" {
+			return []byte(v.String()), nil
+		}
+		if n, err := v.Int64(); err == nil {
+			return n, nil
+		}
+		if n, err := v.Float64(); err == nil && !isIntegerType(c.baseType) {
+			return n, nil
+		}
+		// Out-of-range integers (e.g. large BIGINT UNSIGNED) keep their exact text
+		return []byte(v.String()), nil
+	case string:
+		if r.parseTime && isTimeType(c.baseType) {
+			for _, layout := range timeLayouts {
+				if t, err := time.ParseInLocation(layout, v, time.UTC); err == nil {
+					return t, nil
+				}
+			}
+		}
+		return v, nil
+	default:
+		// JSON columns and other structured values are passed through as JSON text
+		return json.Marshal(v)
+	}
+}
+
+// ColumnTypeDatabaseTypeName returns the upper-case MySQL type name, e.g. "VARCHAR" or "UNSIGNED BIGINT"
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	c := r.columns[index]
+	if c.info.Unsigned && c.baseType != "" {
+		return "UNSIGNED " + c.baseType
+	}
+	return c.baseType
+}
+
+func (r *rows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	c := r.columns[index]
+	if c.baseType == "" {
+		return false, false
+	}
+	return c.info.Nullable, true
+}
+
+// ColumnTypeScanType returns a Go type that can hold every value of the column,
+// e.g. uint64 for BIGINT UNSIGNED
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	c := r.columns[index]
+	nullable := c.info.Nullable
+	switch {
+	case c.baseType == "":
+		return scanTypeUnknown
+	case c.baseType == "BIGINT" && c.info.Unsigned:
+		// Values above MaxInt64 arrive as text, which converts into uint64 but not int64
+		if nullable {
+			return scanTypeNullUint64
+		}
+		return scanTypeUint64
+	case isIntegerType(c.baseType):
+		if nullable {
+			return scanTypeNullInt64
+		}
+		return scanTypeInt64
+	case c.baseType == "FLOAT" || c.baseType == "DOUBLE" || c.baseType == "REAL":
+		if nullable {
+			return scanTypeNullFloat64
+		}
+		return scanTypeFloat64
+	case isTimeType(c.baseType) && r.parseTime:
+		if nullable {
+			return scanTypeNullTime
+		}
+		return scanTypeTime
+	case c.baseType == "JSON" || strings.HasSuffix(c.baseType, "BLOB") || strings.HasSuffix(c.baseType, "BINARY"):
+		return scanTypeBytes
+	default:
+		if nullable {
+			return scanTypeNullString
+		}
+		return scanTypeString
+	}
+}
+
+func (r *rows) ColumnTypeLength(index int) (length int64, ok bool) {
+	c := r.columns[index]
+	if c.info.MaxLength == nil {
+		return 0, false
+	}
+	return *c.info.MaxLength, true
+}
+
+func (r *rows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	c := r.columns[index]
+	if c.baseType != "DECIMAL" && c.baseType != "NUMERIC" || c.info.NumericPrecision == nil {
+		return 0, 0, false
+	}
+
+	if c.info.NumericScale != nil {
+		scale = int64(*c.info.NumericScale)
+	}
+	return int64(*c.info.NumericPrecision), scale, true
+}
+
+func isIntegerType(baseType string) bool {
+	switch baseType {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "YEAR", "BIT":
+		return true
+	}
+	return false
+}
+
+func isTimeType(baseType string) bool {
+	switch baseType {
+	case "DATE", "DATETIME", "TIMESTAMP":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package sqldriver
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// queryResponse is served for every query: one row covering the column types the driver converts
+const queryResponse = `{
+	"columns": [
+		{"name": "id", "type": "bigint unsigned", "nullable": false},
+		{"name": "parent_id", "type": "bigint unsigned", "nullable": true},
+		{"name": "age", "type": "int", "nullable": true},
+		{"name": "name", "type": "varchar(64)", "nullable": false},
+		{"name": "price", "type": "decimal(10,2)", "nullable": false},
+		{"name": "score", "type": "double", "nullable": false},
+		{"name": "created_at", "type": "datetime", "nullable": false},
+		{"name": "meta", "type": "json", "nullable": true},
+		{"name": "sign", "type": "enum('signed','unsigned')", "nullable": false}
+	],
+	"data": [{
+		"id": 18446744073709551615,
+		"parent_id": null,
+		"age": 42,
+		"name": "ada",
+		"price": 19.90,
+		"score": 1.5,
+		"created_at": "2024-03-01 12:30:00",
+		"meta": {"admin": true},
+		"sign": "unsigned"
+	}]
+}`
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, queryResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	db, err := sql.Open(DriverName, "wowmysql://test-key@"+host+"?tls=false&parseTime=true")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestRowsScan(t *testing.T) {
+	db := openTestDB(t)
+
+	var (
+		id        uint64
+		parentID  sql.Null[uint64]
+		age       sql.NullInt64
+		name      string
+		price     string
+		score     float64
+		createdAt time.Time
+		meta      []byte
+		sign      string
+	)
+	err := db.QueryRow("SELECT * FROM users").Scan(&id, &parentID, &age, &name, &price, &score, &createdAt, &meta, &sign)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+
+	if id != 18446744073709551615 || parentID.Valid || age.Int64 != 42 || name != "ada" || price != "19.90" || score != 1.5 {
+		t.Errorf("scanned id=%d parent_id=%v age=%v name=%q price=%q score=%v", id, parentID, age, name, price, score)
+	}
+	if want := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC); !createdAt.Equal(want) {
+		t.Errorf("created_at = %v, want %v", createdAt, want)
+	}
+	if string(meta) != `{"admin":true}` {
+		t.Errorf("meta = %s, want {\"admin\":true}", meta)
+	}
+	if sign != "unsigned" {
+		t.Errorf("sign = %q, want unsigned", sign)
+	}
+}
+
+func TestColumnTypes(t *testing.T) {
+	db := openTestDB(t)
+
+	rows, err := db.Query("SELECT * FROM users")
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatalf("ColumnTypes: %v", err)
+	}
+
+	want := []struct {
+		name     string
+		dbType   string
+		nullable bool
+		scanType reflect.Type
+	}{
+		{"id", "UNSIGNED BIGINT", false, scanTypeUint64},
+		{"parent_id", "UNSIGNED BIGINT", true, scanTypeNullUint64},
+		{"age", "INT", true, scanTypeNullInt64},
+		{"name", "VARCHAR", false, scanTypeString},
+		{"price", "DECIMAL", false, scanTypeString},
+		{"score", "DOUBLE", false, scanTypeFloat64},
+		{"created_at", "DATETIME", false, scanTypeTime},
+		{"meta", "JSON", true, scanTypeBytes},
+		{"sign", "ENUM", false, scanTypeString},
+	}
+	if len(types) != len(want) {
+		t.Fatalf("got %d column types, want %d", len(types), len(want))
+	}
+
+	for i, w := range want {
+		ct := types[i]
+		nullable, ok := ct.Nullable()
+		if ct.Name() != w.name || ct.DatabaseTypeName() != w.dbType || !ok || nullable != w.nullable || ct.ScanType() != w.scanType {
+			t.Errorf("column %d = %s %s nullable=%v scan=%v; want %s %s nullable=%v scan=%v",
+				i, ct.Name(), ct.DatabaseTypeName(), nullable, ct.ScanType(), w.name, w.dbType, w.nullable, w.scanType)
+		}
+	}
+
+	if length, ok := types[3].Length(); !ok || length != 64 {
+		t.Errorf("name length = %d, %v; want 64, true", length, ok)
+	}
+	if precision, scale, ok := types[4].DecimalSize(); !ok || precision != 10 || scale != 2 {
+		t.Errorf("price size = %d,%d, %v; want 10,2, true", precision, scale, ok)
+	}
+}
@@ -2,6 +2,7 @@ package wowmysql
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,36 +16,37 @@ type StorageClient struct {
 	projectURL     string
 	apiKey         string
 	httpClient     *http.Client
+	options        *clientOptions
 	autoCheckQuota bool
 }
 
 // NewStorageClient creates a new storage client
-func NewStorageClient(projectURL, apiKey string) *StorageClient {
+func NewStorageClient(projectURL, apiKey string, opts ...Option) *StorageClient {
+	options := applyOptions(opts)
 	return &StorageClient{
 		projectURL:     projectURL,
 		apiKey:         apiKey,
 		autoCheckQuota: true,
-		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		httpClient:     options.buildHTTPClient(60 * time.Second),
+		options:        options,
 	}
 }
 
 // NewStorageClientWithOptions creates a new storage client with options
-func NewStorageClientWithOptions(projectURL, apiKey string, timeout time.Duration, autoCheckQuota bool) *StorageClient {
-	return &StorageClient{
-		projectURL:     projectURL,
-		apiKey:         apiKey,
-		autoCheckQuota: autoCheckQuota,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-	}
+func NewStorageClientWithOptions(projectURL, apiKey string, timeout time.Duration, autoCheckQuota bool, opts ...Option) *StorageClient {
+	client := NewStorageClient(projectURL, apiKey, append([]Option{WithTimeout(timeout)}, opts...)...)
+	client.autoCheckQuota = autoCheckQuota
+	return client
 }
 
 // GetQuota retrieves storage quota information
 func (s *StorageClient) GetQuota() (*StorageQuota, error) {
-	resp, err := s.doRequest("GET", "/api/v1/storage/quota", nil)
+	return s.GetQuotaContext(context.Background())
+}
+
+// GetQuotaContext retrieves storage quota information using the provided context
+func (s *StorageClient) GetQuotaContext(ctx context.Context) (*StorageQuota, error) {
+	resp, err := s.doRequest(ctx, "GET", "/api/v1/storage/quota", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +61,11 @@ func (s *StorageClient) GetQuota() (*StorageQuota, error) {
 
 // Upload uploads a file to storage
 func (s *StorageClient) Upload(fileData []byte, key string, contentType string, checkQuota *bool) (*FileUploadResult, error) {
+	return s.UploadContext(context.Background(), fileData, key, contentType, checkQuota)
+}
+
+// UploadContext uploads a file to storage using the provided context
+func (s *StorageClient) UploadContext(ctx context.Context, fileData []byte, key string, contentType string, checkQuota *bool) (*FileUploadResult, error) {
 	shouldCheck := s.autoCheckQuota
 	if checkQuota != nil {
 		shouldCheck = *checkQuota
@@ -66,7 +73,7 @@ func (s *StorageClient) Upload(fileData []byte, key string, contentType string,
 
 	// Check quota if enabled
 	if shouldCheck {
-		quota, err := s.GetQuota()
+		quota, err := s.GetQuotaContext(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -112,15 +119,16 @@ func (s *StorageClient) Upload(fileData []byte, key string, contentType string,
 
 	// Make request
 	url := s.projectURL + "/api/v1/storage/upload"
-	req, err := http.NewRequest("POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	s.options.setHeaders(req)
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("Authorization", "Bearer "+s.apiKey)
 
-	resp, err := s.httpClient.Do(req)
+	resp, err := s.options.retryPolicyOrNil().send(ctx, httpClientForContext(ctx, s.httpClient), req, false)
 	if err != nil {
 		return nil, &StorageError{Err: err}
 	}
@@ -145,8 +153,13 @@ func (s *StorageClient) Upload(fileData []byte, key string, contentType string,
 
 // Download gets a presigned URL for downloading a file
 func (s *StorageClient) Download(key string, expiresIn int) (string, error) {
+	return s.DownloadContext(context.Background(), key, expiresIn)
+}
+
+// DownloadContext gets a presigned URL for downloading a file using the provided context
+func (s *StorageClient) DownloadContext(ctx context.Context, key string, expiresIn int) (string, error) {
 	url := fmt.Sprintf("/api/v1/storage/download?key=%s&expires_in=%d", key, expiresIn)
-	resp, err := s.doRequest("GET", url, nil)
+	resp, err := s.doRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return "", err
 	}
@@ -163,6 +176,11 @@ func (s *StorageClient) Download(key string, expiresIn int) (string, error) {
 
 // ListFiles lists files in storage
 func (s *StorageClient) ListFiles(prefix string, limit int) ([]StorageFile, error) {
+	return s.ListFilesContext(context.Background(), prefix, limit)
+}
+
+// ListFilesContext lists files in storage using the provided context
+func (s *StorageClient) ListFilesContext(ctx context.Context, prefix string, limit int) ([]StorageFile, error) {
 	url := "/api/v1/storage/list"
 	if prefix != "" || limit > 0 {
 		url += "?"
@@ -177,7 +195,7 @@ func (s *StorageClient) ListFiles(prefix string, limit int) ([]StorageFile, erro
 		}
 	}
 
-	resp, err := s.doRequest("GET", url, nil)
+	resp, err := s.doRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -194,28 +212,43 @@ func (s *StorageClient) ListFiles(prefix string, limit int) ([]StorageFile, erro
 
 // DeleteFile deletes a single file
 func (s *StorageClient) DeleteFile(key string) error {
+	return s.DeleteFileContext(context.Background(), key)
+}
+
+// DeleteFileContext deletes a single file using the provided context
+func (s *StorageClient) DeleteFileContext(ctx context.Context, key string) error {
 	body := map[string]interface{}{
 		"key": key,
 	}
 
-	_, err := s.doRequest("DELETE", "/api/v1/storage/delete", body)
+	_, err := s.doRequest(ctx, "DELETE", "/api/v1/storage/delete", body)
 	return err
 }
 
 // DeleteFiles deletes multiple files
 func (s *StorageClient) DeleteFiles(keys []string) error {
+	return s.DeleteFilesContext(context.Background(), keys)
+}
+
+// DeleteFilesContext deletes multiple files using the provided context
+func (s *StorageClient) DeleteFilesContext(ctx context.Context, keys []string) error {
 	body := map[string]interface{}{
 		"keys": keys,
 	}
 
-	_, err := s.doRequest("DELETE", "/api/v1/storage/delete-batch", body)
+	_, err := s.doRequest(ctx, "DELETE", "/api/v1/storage/delete-batch", body)
 	return err
 }
 
 // GetFileInfo gets information about a file
 func (s *StorageClient) GetFileInfo(key string) (*StorageFile, error) {
+	return s.GetFileInfoContext(context.Background(), key)
+}
+
+// GetFileInfoContext gets information about a file using the provided context
+func (s *StorageClient) GetFileInfoContext(ctx context.Context, key string) (*StorageFile, error) {
 	url := fmt.Sprintf("/api/v1/storage/info?key=%s", key)
-	resp, err := s.doRequest("GET", url, nil)
+	resp, err := s.doRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +263,12 @@ func (s *StorageClient) GetFileInfo(key string) (*StorageFile, error) {
 
 // FileExists checks if a file exists
 func (s *StorageClient) FileExists(key string) (bool, error) {
-	_, err := s.GetFileInfo(key)
+	return s.FileExistsContext(context.Background(), key)
+}
+
+// FileExistsContext checks if a file exists using the provided context
+func (s *StorageClient) FileExistsContext(ctx context.Context, key string) (bool, error) {
+	_, err := s.GetFileInfoContext(ctx, key)
 	if err != nil {
 		if _, ok := err.(*NotFoundError); ok {
 			return false, nil
@@ -241,7 +279,7 @@ func (s *StorageClient) FileExists(key string) (bool, error) {
 }
 
 // doRequest performs an HTTP request
-func (s *StorageClient) doRequest(method, path string, body interface{}) ([]byte, error) {
+func (s *StorageClient) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -252,16 +290,17 @@ func (s *StorageClient) doRequest(method, path string, body interface{}) ([]byte
 	}
 
 	url := s.projectURL + path
-	req, err := http.NewRequest(method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	s.options.setHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+s.apiKey)
 
-	resp, err := s.httpClient.Do(req)
+	resp, err := s.options.retryPolicyOrNil().send(ctx, httpClientForContext(ctx, s.httpClient), req, isIdempotentMethod(method))
 	if err != nil {
 		return nil, &StorageError{Err: err}
 	}
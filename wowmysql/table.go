@@ -1,14 +1,76 @@
 package wowmysql
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 )
 
 // Table represents a database table with fluent API
 type Table struct {
-	client    *Client
-	tableName string
+	client     *Client
+	tableName  string
+	primaryKey []string
+	tx         *Tx
+}
+
+// WithPrimaryKey returns a copy of the table that uses the given primary key
+// columns instead of discovering them from the table schema
+func (t *Table) WithPrimaryKey(columns ...string) *Table {
+	clone := *t
+	clone.primaryKey = append([]string(nil), columns...)
+	return &clone
+}
+
+// PrimaryKey returns the primary key columns of the table
+func (t *Table) PrimaryKey() ([]string, error) {
+	return t.PrimaryKeyContext(context.Background())
+}
+
+// PrimaryKeyContext returns the primary key columns of the table using the provided context.
+// Columns set with WithPrimaryKey take priority; otherwise they come from the cached
+// table schema (all columns of a composite key), falling back to "id" when the schema
+// reports no primary key or cannot be read with the current credentials. The fallback
+// is cached like a schema, so restricted keys do not retry the schema on every lookup.
+func (t *Table) PrimaryKeyContext(ctx context.Context) ([]string, error) {
+	if len(t.primaryKey) > 0 {
+		return append([]string(nil), t.primaryKey...), nil
+	}
+	if _, ok := t.client.schemas.peek(primaryKeyFallbackKey(t.tableName)); ok {
+		return []string{"id"}, nil
+	}
+
+	schema, err := t.client.cachedSchema(ctx, t.tableName)
+	if err != nil {
+		var authErr *AuthenticationError
+		var notFoundErr *NotFoundError
+		if errors.As(err, &authErr) || errors.As(err, &notFoundErr) {
+			t.client.schemas.put(primaryKeyFallbackKey(t.tableName), true)
+			return []string{"id"}, nil
+		}
+		return nil, err
+	}
+
+	if columns := schema.PrimaryKeyColumns(); len(columns) > 0 {
+		return columns, nil
+	}
+	return []string{"id"}, nil
+}
+
+// knownPrimaryKey returns the primary key columns without contacting the server:
+// the explicit key, the cached schema's key, or "id"
+func (t *Table) knownPrimaryKey() []string {
+	if len(t.primaryKey) > 0 {
+		return t.primaryKey
+	}
+	if schema, ok := t.client.schemas.peek(t.tableName); ok {
+		if columns := schema.(*TableSchema).PrimaryKeyColumns(); len(columns) > 0 {
+			return columns
+		}
+	}
+	return []string{"id"}
 }
 
 // Select creates a new QueryBuilder for select queries
@@ -18,6 +80,7 @@ func (t *Table) Select(columns ...string) *QueryBuilder {
 		tableName: t.tableName,
 		columns:   columns,
 		filters:   make([]FilterExpression, 0),
+		tx:        t.tx,
 	}
 }
 
@@ -26,19 +89,31 @@ func (t *Table) Get() *QueryBuilder {
 	return t.Select("*")
 }
 
-// GetByID retrieves a single record by ID
+// GetByID retrieves a single record by its primary key.
+// The primary key column is resolved when the query is executed.
 func (t *Table) GetByID(id interface{}) *QueryBuilder {
-	return t.Select("*").Eq("id", id).Limit(1)
+	qb := t.Select("*").Limit(1)
+	qb.pendingID = &pendingID{table: t, id: id}
+	return qb
+}
+
+// GetByKey retrieves a single record by a (possibly composite) key
+func (t *Table) GetByKey(key map[string]interface{}) *QueryBuilder {
+	return t.whereKey(t.Select("*"), key).Limit(1)
 }
 
 // Insert inserts a new record
 func (t *Table) Insert(data map[string]interface{}) (*CreateResponse, error) {
-	jsonBody, err := json.Marshal(data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	return t.InsertContext(context.Background(), data)
+}
+
+// InsertContext inserts a new record using the provided context
+func (t *Table) InsertContext(ctx context.Context, data map[string]interface{}) (*CreateResponse, error) {
+	if err := t.validateRows(ctx, []map[string]interface{}{data}, nil, nil); err != nil {
+		return nil, err
 	}
 
-	resp, err := t.client.doRequest("POST", fmt.Sprintf("/api/v1/tables/%s", t.tableName), data)
+	resp, err := t.doWrite(ctx, "POST", fmt.Sprintf("/api/v1/tables/%s", t.tableName), data)
 	if err != nil {
 		return nil, err
 	}
@@ -51,14 +126,151 @@ func (t *Table) Insert(data map[string]interface{}) (*CreateResponse, error) {
 	return &result, nil
 }
 
+// InsertStruct inserts a new record built from the tagged fields of a struct
+func (t *Table) InsertStruct(v interface{}) (*CreateResponse, error) {
+	return t.InsertStructContext(context.Background(), v)
+}
+
+// InsertStructContext inserts a new record built from a struct using the provided context
+func (t *Table) InsertStructContext(ctx context.Context, v interface{}) (*CreateResponse, error) {
+	data, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+	return t.InsertContext(ctx, data)
+}
+
+// Upsert inserts a record, or updates the existing row when it collides with a
+// primary or unique key (INSERT ... ON DUPLICATE KEY UPDATE).
+// conflictColumns names the key the row may collide with; updateColumns lists the
+// columns overwritten on conflict, defaulting to every non-conflict column when empty.
+func (t *Table) Upsert(data map[string]interface{}, conflictColumns, updateColumns []string) (*UpsertResponse, error) {
+	return t.UpsertContext(context.Background(), data, conflictColumns, updateColumns)
+}
+
+// UpsertContext upserts a record using the provided context
+func (t *Table) UpsertContext(ctx context.Context, data map[string]interface{}, conflictColumns, updateColumns []string) (*UpsertResponse, error) {
+	if err := t.validateRows(ctx, []map[string]interface{}{data}, conflictColumns, updateColumns); err != nil {
+		return nil, err
+	}
+
+	body := buildUpsertBody(data, conflictColumns, updateColumns)
+
+	resp, err := t.doWrite(ctx, "POST", fmt.Sprintf("/api/v1/tables/%s/upsert", t.tableName), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result UpsertResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpsertStruct inserts a record built from a struct, or updates the existing
+// row when it collides with a primary or unique key
+func (t *Table) UpsertStruct(v interface{}) (*UpsertResponse, error) {
+	return t.UpsertStructContext(context.Background(), v)
+}
+
+// UpsertStructContext upserts a record built from a struct using the provided context
+func (t *Table) UpsertStructContext(ctx context.Context, v interface{}) (*UpsertResponse, error) {
+	data, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+	return t.UpsertContext(ctx, data, nil, nil)
+}
+
+// buildUpsertBody builds the upsert request body for one row or a batch of rows
+func buildUpsertBody(data interface{}, conflictColumns, updateColumns []string) map[string]interface{} {
+	body := map[string]interface{}{
+		"data": data,
+	}
+
+	if len(conflictColumns) > 0 {
+		body["conflict_columns"] = conflictColumns
+	}
+
+	if len(updateColumns) > 0 {
+		body["update_columns"] = updateColumns
+	}
+
+	return body
+}
+
 // UpdateByID updates a record by ID
 func (t *Table) UpdateByID(id interface{}, data map[string]interface{}) (*UpdateResponse, error) {
-	return t.Where().Eq("id", id).Update(data)
+	return t.UpdateByIDContext(context.Background(), id, data)
+}
+
+// UpdateByIDContext updates a record by ID using the provided context
+func (t *Table) UpdateByIDContext(ctx context.Context, id interface{}, data map[string]interface{}) (*UpdateResponse, error) {
+	qb := t.Where()
+	qb.pendingID = &pendingID{table: t, id: id}
+	return qb.UpdateContext(ctx, data)
+}
+
+// UpdateByKey updates a record by a (possibly composite) key
+func (t *Table) UpdateByKey(key map[string]interface{}, data map[string]interface{}) (*UpdateResponse, error) {
+	return t.UpdateByKeyContext(context.Background(), key, data)
+}
+
+// UpdateByKeyContext updates a record by a (possibly composite) key using the provided context
+func (t *Table) UpdateByKeyContext(ctx context.Context, key map[string]interface{}, data map[string]interface{}) (*UpdateResponse, error) {
+	return t.whereKey(t.Where(), key).UpdateContext(ctx, data)
 }
 
 // DeleteByID deletes a record by ID
 func (t *Table) DeleteByID(id interface{}) (*DeleteResponse, error) {
-	return t.Where().Eq("id", id).Delete()
+	return t.DeleteByIDContext(context.Background(), id)
+}
+
+// DeleteByIDContext deletes a record by ID using the provided context
+func (t *Table) DeleteByIDContext(ctx context.Context, id interface{}) (*DeleteResponse, error) {
+	qb := t.Where()
+	qb.pendingID = &pendingID{table: t, id: id}
+	return qb.DeleteContext(ctx)
+}
+
+// DeleteByKey deletes a record by a (possibly composite) key
+func (t *Table) DeleteByKey(key map[string]interface{}) (*DeleteResponse, error) {
+	return t.DeleteByKeyContext(context.Background(), key)
+}
+
+// DeleteByKeyContext deletes a record by a (possibly composite) key using the provided context
+func (t *Table) DeleteByKeyContext(ctx context.Context, key map[string]interface{}) (*DeleteResponse, error) {
+	return t.whereKey(t.Where(), key).DeleteContext(ctx)
+}
+
+// doWrite sends a write request, or queues it when the table belongs to a transaction
+func (t *Table) doWrite(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	if t.tx != nil {
+		return t.tx.queue(method, path, body)
+	}
+	return t.client.doRequest(ctx, method, path, body)
+}
+
+// whereKey adds an equality filter for each key column, in column order.
+// An empty key is recorded as an error rather than matching every row.
+func (t *Table) whereKey(qb *QueryBuilder, key map[string]interface{}) *QueryBuilder {
+	if len(key) == 0 {
+		qb.err = &WowMySQLError{Message: fmt.Sprintf("key for table %s must contain at least one column", t.tableName)}
+		return qb
+	}
+
+	columns := make([]string, 0, len(key))
+	for column := range key {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		qb = qb.Eq(column, key[column])
+	}
+	return qb
 }
 
 // Where creates a new QueryBuilder for filtered operations
@@ -67,6 +279,6 @@ func (t *Table) Where() *QueryBuilder {
 		client:    t.client,
 		tableName: t.tableName,
 		filters:   make([]FilterExpression, 0),
+		tx:        t.tx,
 	}
 }
-
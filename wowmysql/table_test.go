@@ -0,0 +1,43 @@
+package wowmysql
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPrimaryKeyFallbackIsCached(t *testing.T) {
+	var schemaRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/schema") {
+			schemaRequests.Add(1)
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":"schema access denied"}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":1}],"count":1}`)
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(srv.URL, "restricted-key")
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.Table("users").GetByID(i).First(); err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+	}
+	if n := schemaRequests.Load(); n != 1 {
+		t.Errorf("schema requested %d times, want 1", n)
+	}
+
+	client.InvalidateSchema("users")
+	if _, err := client.Table("users").PrimaryKey(); err != nil {
+		t.Fatalf("PrimaryKey: %v", err)
+	}
+	if n := schemaRequests.Load(); n != 2 {
+		t.Errorf("schema requested %d times after InvalidateSchema, want 2", n)
+	}
+}
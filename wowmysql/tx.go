@@ -0,0 +1,151 @@
+package wowmysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ErrTxDone is returned when using a transaction that has already been committed or rolled back
+var ErrTxDone = &WowMySQLError{Message: "transaction has already been committed or rolled back"}
+
+// ErrTxQuery is returned when running a query on a table that belongs to a transaction.
+// Transactions batch writes into a single request, so rows cannot be read back before commit.
+var ErrTxQuery = &WowMySQLError{Message: "queries cannot run inside a transaction; use the client's tables for reads"}
+
+// Tx groups Insert, Update and Delete operations that are committed atomically.
+// Operations on its tables are queued locally and sent as a single transactional
+// request on Commit; their individual responses only carry Success until then.
+type Tx struct {
+	client     *Client
+	mu         sync.Mutex
+	operations []txOperation
+	done       bool
+}
+
+// txOperation is a queued write request
+type txOperation struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// TxResponse represents a transaction commit response
+type TxResponse struct {
+	Results []TxOperationResult `json:"results"`
+	Success bool                `json:"success"`
+}
+
+// TxOperationResult represents the result of one operation in a committed transaction
+type TxOperationResult struct {
+	ID           interface{} `json:"id,omitempty"`
+	AffectedRows int         `json:"affected_rows"`
+}
+
+// Begin starts a transaction. Call Commit or Rollback to finish it.
+func (c *Client) Begin() *Tx {
+	return &Tx{client: c}
+}
+
+// Transaction runs fn inside a transaction, committing it when fn returns nil
+// and rolling it back when fn returns an error or panics
+func (c *Client) Transaction(ctx context.Context, fn func(tx *Tx) error) error {
+	tx := c.Begin()
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err := tx.Commit(ctx)
+	return err
+}
+
+// Table returns a Table whose write operations are queued in the transaction
+func (tx *Tx) Table(tableName string) *Table {
+	return &Table{
+		client:    tx.client,
+		tableName: tableName,
+		tx:        tx,
+	}
+}
+
+// Commit sends all queued operations as a single atomic request
+func (tx *Tx) Commit(ctx context.Context) (*TxResponse, error) {
+	tx.mu.Lock()
+	if tx.done {
+		tx.mu.Unlock()
+		return nil, ErrTxDone
+	}
+	tx.done = true
+	operations := tx.operations
+	tx.operations = nil
+	tx.mu.Unlock()
+
+	if len(operations) == 0 {
+		return &TxResponse{Success: true}, nil
+	}
+
+	body := map[string]interface{}{
+		"operations": operations,
+	}
+
+	resp, err := tx.client.doRequest(ctx, "POST", "/api/v1/transactions", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TxResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// Rollback discards all queued operations
+func (tx *Tx) Rollback() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return ErrTxDone
+	}
+	tx.done = true
+	tx.operations = nil
+	return nil
+}
+
+// queue records a write request and returns a placeholder success response.
+// The body is marshalled immediately so later changes to the caller's maps or
+// slices do not leak into the queued operation.
+func (tx *Tx) queue(method, path string, body interface{}) ([]byte, error) {
+	var raw json.RawMessage
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		raw = encoded
+	}
+
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.done {
+		return nil, ErrTxDone
+	}
+	tx.operations = append(tx.operations, txOperation{
+		Method: method,
+		Path:   path,
+		Body:   raw,
+	})
+	return []byte(`{"success":true}`), nil
+}
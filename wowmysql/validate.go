@@ -0,0 +1,220 @@
+package wowmysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// validator checks column names and operators against cached table schemas
+type validator struct {
+	table   string
+	columns map[string]ColumnInfo // keyed by name and by table-qualified name
+	fields  []FieldError
+}
+
+// newValidator returns a validator for a table, or nil when validation is disabled
+func (c *Client) newValidator(ctx context.Context, table string) (*validator, error) {
+	if c.options == nil || !c.options.validate {
+		return nil, nil
+	}
+
+	v := &validator{table: table, columns: make(map[string]ColumnInfo)}
+	if err := v.addTable(ctx, c, table, true); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// addTable makes the columns of a table known; unqualified names are added only when
+// unqualified is set so that the queried table wins over joined tables
+func (v *validator) addTable(ctx context.Context, c *Client, table string, unqualified bool) error {
+	schema, err := c.cachedSchema(ctx, table)
+	if err != nil {
+		return err
+	}
+	for _, column := range schema.Columns {
+		v.columns[table+"."+column.Name] = column
+		if _, exists := v.columns[column.Name]; unqualified || !exists {
+			v.columns[column.Name] = column
+		}
+	}
+	return nil
+}
+
+// column looks up a column, recording an error when it does not exist.
+// "*", "table.*" and expressions are not checked; names in allowed are accepted as-is.
+func (v *validator) column(name, clause string, allowed map[string]bool) (ColumnInfo, bool) {
+	if name == "" || name == "*" || strings.HasSuffix(name, ".*") || strings.ContainsAny(name, "() ") || allowed[name] {
+		return ColumnInfo{}, false
+	}
+	if column, ok := v.columns[name]; ok {
+		return column, true
+	}
+	v.fail(name, "unknown column in "+clause)
+	return ColumnInfo{}, false
+}
+
+// filters checks the columns and operators of a filter tree
+func (v *validator) filters(filters []FilterExpression, clause string, allowed map[string]bool) {
+	for _, filter := range filters {
+		if filter.Logic != "" {
+			v.filters(filter.Filters, clause, allowed)
+			continue
+		}
+
+		column, ok := v.column(filter.Column, clause, allowed)
+		if !ok {
+			continue
+		}
+		switch filter.Operator {
+		case OpLike, OpILike, OpNotLike:
+			if column.Type != "" && !isPatternType(column.Type) {
+				v.fail(filter.Column, fmt.Sprintf("operator %s requires a string, binary, temporal or JSON column, got %s", filter.Operator, column.Type))
+			}
+		}
+	}
+}
+
+// fail records a problem with a field
+func (v *validator) fail(field, reason string) {
+	v.fields = append(v.fields, FieldError{Field: field, Reason: reason})
+}
+
+// err returns a ValidationError listing every problem found, or nil
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	problems := make([]string, len(v.fields))
+	for i, field := range v.fields {
+		problems[i] = field.Field + ": " + field.Reason
+	}
+	return &ValidationError{
+		WowMySQLError: WowMySQLError{
+			Message: fmt.Sprintf("invalid request for table %s: %s", v.table, strings.Join(problems, "; ")),
+		},
+		Table:  v.table,
+		Fields: v.fields,
+	}
+}
+
+// validateQuery checks a query's columns, filters, grouping and ordering
+func (qb *QueryBuilder) validateQuery(ctx context.Context) error {
+	v, err := qb.client.newValidator(ctx, qb.tableName)
+	if v == nil || err != nil {
+		return err
+	}
+	for _, join := range qb.joins {
+		if err := v.addTable(ctx, qb.client, join.Table, false); err != nil {
+			return err
+		}
+	}
+
+	aliases := make(map[string]bool, len(qb.aggregates))
+	for _, aggregate := range qb.aggregates {
+		aliases[aggregate.Alias] = true
+	}
+
+	for _, column := range qb.columns {
+		v.column(column, "select", nil)
+	}
+	for _, aggregate := range qb.aggregates {
+		column, ok := v.column(aggregate.Column, string(aggregate.Function), nil)
+		if ok && column.Type != "" && (aggregate.Function == AggSum || aggregate.Function == AggAvg) && !isNumericType(column.Type) {
+			v.fail(aggregate.Column, fmt.Sprintf("%s requires a numeric column, got %s", aggregate.Function, column.Type))
+		}
+	}
+	v.filters(qb.filters, "filter", nil)
+	for _, column := range qb.groupBy {
+		v.column(column, "group by", nil)
+	}
+	v.filters(qb.having, "having", aliases)
+	for _, key := range qb.order {
+		v.column(key.Column, "order by", aliases)
+	}
+
+	return v.err()
+}
+
+// validateWrite checks the filters and data columns of an update or delete
+func (qb *QueryBuilder) validateWrite(ctx context.Context, data map[string]interface{}) error {
+	v, err := qb.client.newValidator(ctx, qb.tableName)
+	if v == nil || err != nil {
+		return err
+	}
+
+	v.filters(qb.filters, "filter", nil)
+	for _, key := range sortedKeys(data) {
+		v.column(key, "data", nil)
+	}
+	return v.err()
+}
+
+// validateRows checks the data columns of inserted or upserted rows and the upsert's
+// conflict and update columns
+func (t *Table) validateRows(ctx context.Context, rows []map[string]interface{}, conflictColumns, updateColumns []string) error {
+	v, err := t.client.newValidator(ctx, t.tableName)
+	if v == nil || err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		for _, key := range sortedKeys(row) {
+			if !seen[key] {
+				seen[key] = true
+				v.column(key, "data", nil)
+			}
+		}
+	}
+	for _, column := range conflictColumns {
+		v.column(column, "conflict columns", nil)
+	}
+	for _, column := range updateColumns {
+		v.column(column, "update columns", nil)
+	}
+	return v.err()
+}
+
+// sortedKeys returns the keys of a row in a stable order for reporting
+func sortedKeys(row map[string]interface{}) []string {
+	keys := make([]string, 0, len(row))
+	for key := range row {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// columnBaseType returns the upper-case MySQL type name without length or modifiers
+func columnBaseType(typ string) string {
+	typ = strings.TrimSpace(typ)
+	if i := strings.IndexAny(typ, "( "); i >= 0 {
+		typ = typ[:i]
+	}
+	return strings.ToUpper(typ)
+}
+
+// isPatternType reports whether LIKE is meaningful on a column type: string, binary,
+// temporal and JSON values are matched on their text form, e.g. created_at LIKE '2024-%'
+func isPatternType(typ string) bool {
+	switch columnBaseType(typ) {
+	case "CHAR", "VARCHAR", "TINYTEXT", "TEXT", "MEDIUMTEXT", "LONGTEXT", "ENUM", "SET",
+		"BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB",
+		"DATE", "TIME", "DATETIME", "TIMESTAMP", "JSON":
+		return true
+	}
+	return false
+}
+
+func isNumericType(typ string) bool {
+	switch columnBaseType(typ) {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT",
+		"DECIMAL", "NUMERIC", "FLOAT", "DOUBLE", "REAL", "BIT", "YEAR":
+		return true
+	}
+	return false
+}